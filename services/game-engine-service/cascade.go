@@ -0,0 +1,226 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// Fill modes for CascadeConfig.Fill.
+const (
+	FillStrip = "strip" // continue each reel's strip above the window (default)
+	FillRNG   = "rng"   // draw each new symbol from FillWeights
+)
+
+// maxCascadeSteps bounds a round when the config sets no MaxSteps.
+const maxCascadeSteps = 100
+
+// CascadeConfig describes cascading (tumbling) reels: after each
+// evaluation the winning symbols are cleared, the symbols above drop into
+// the gaps and new symbols fill the reels from the top, until a step has no
+// wins.
+type CascadeConfig struct {
+	// Multipliers applies to the wins of each step in turn, starting with
+	// the initial grid; the last entry covers every later step, e.g.
+	// [1, 2, 3, 5]. Empty means 1 throughout.
+	Multipliers []int `json:"multipliers"`
+	// Fill is FillStrip or FillRNG.
+	Fill string `json:"fill"`
+	// FillWeights are the symbol weights for FillRNG.
+	FillWeights map[string]int `json:"fill_weights"`
+	// MaxSteps caps the evaluations in one round (default maxCascadeSteps).
+	MaxSteps int `json:"max_steps"`
+}
+
+func (c *CascadeConfig) validate() error {
+	for _, m := range c.Multipliers {
+		if m < 1 {
+			return fmt.Errorf("multiplier %d must be at least 1", m)
+		}
+	}
+	switch c.Fill {
+	case "", FillStrip:
+	case FillRNG:
+		total := 0
+		for symbol, w := range c.FillWeights {
+			if w < 0 {
+				return fmt.Errorf("negative fill weight for %s", symbol)
+			}
+			total += w
+		}
+		if total == 0 {
+			return errors.New("rng fill needs positive fill_weights")
+		}
+	default:
+		return fmt.Errorf("unknown fill %q", c.Fill)
+	}
+	if c.MaxSteps < 0 {
+		return errors.New("max_steps must not be negative")
+	}
+	return nil
+}
+
+// multiplier returns the multiplier for step, counting from 0.
+func (c *CascadeConfig) multiplier(step int) int {
+	if len(c.Multipliers) == 0 {
+		return 1
+	}
+	if step >= len(c.Multipliers) {
+		step = len(c.Multipliers) - 1
+	}
+	return c.Multipliers[step]
+}
+
+// weightTable returns the keys of weights in a stable order, with their
+// weights as an RNG weight table: index i of the table is keys[i].
+func weightTable[K cmp.Ordered](weights map[K]int) ([]K, []int64) {
+	keys := slices.Sorted(maps.Keys(weights))
+	table := make([]int64, len(keys))
+	for i, k := range keys {
+		table[i] = int64(weights[k])
+	}
+	return keys, table
+}
+
+// FillFunc draws one index per weight table from the RNG service and
+// returns the indices with the draw's audit id. Cascades with FillRNG use it
+// to pick the symbols that refill cleared cells.
+type FillFunc func(tables [][]int64) ([]int64, string, error)
+
+// CascadeStep is one evaluation in a cascading round.
+type CascadeStep struct {
+	Matrix     [][]string `json:"matrix"` // grid evaluated at this step, rows x reels
+	WinLines   []WinLine  `json:"win_lines"`
+	Multiplier int        `json:"multiplier"` // cascade multiplier for this step
+	Win        int        `json:"win"`
+	// Removed lists the cells cleared after this step's wins.
+	Removed []Position `json:"removed"`
+	// AuditID is the RNG draw that filled this step's grid, for FillRNG.
+	AuditID string `json:"audit_id,omitempty"`
+}
+
+// cascade evaluates matrix, the grid at stops on strips, and keeps clearing
+// and refilling it while it wins. multiplier applies on top of each step's
+// cascade multiplier. The last step returned holds the settled grid.
+func (cfg *GameConfig) cascade(strips [][]string, stops []int64, matrix [][]string, baseBet, multiplier int, fill FillFunc) ([]CascadeStep, error) {
+	c := cfg.Cascade
+	if c.Fill == FillRNG && fill == nil {
+		return nil, errors.New("cascade: rng fill without an RNG")
+	}
+	maxSteps := c.MaxSteps
+	if maxSteps == 0 {
+		maxSteps = maxCascadeSteps
+	}
+	// above[reel] is the strip index just above the visible window.
+	above := make([]int, len(strips))
+	for reel, strip := range strips {
+		above[reel] = (int(stops[reel]) - 1 + len(strip)) % len(strip)
+	}
+
+	var steps []CascadeStep
+	auditID := ""
+	for step := 0; ; step++ {
+		m := c.multiplier(step)
+		wins := applyMultiplier(cfg.evaluateWins(matrix, baseBet), m*multiplier)
+		cs := CascadeStep{Matrix: matrix, WinLines: wins, Multiplier: m, AuditID: auditID}
+		for _, w := range wins {
+			cs.Win += w.Payout
+		}
+		if len(wins) == 0 || step+1 >= maxSteps {
+			return append(steps, cs), nil
+		}
+		cs.Removed = winningCells(wins)
+		steps = append(steps, cs)
+
+		var err error
+		if matrix, auditID, err = cfg.refill(matrix, cs.Removed, strips, above, fill); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// winningCells returns every cell that is part of a win, in reel order.
+func winningCells(wins []WinLine) []Position {
+	seen := map[Position]bool{}
+	var cells []Position
+	for _, w := range wins {
+		for _, p := range w.Positions {
+			if !seen[p] {
+				seen[p] = true
+				cells = append(cells, p)
+			}
+		}
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Reel != cells[j].Reel {
+			return cells[i].Reel < cells[j].Reel
+		}
+		return cells[i].Row < cells[j].Row
+	})
+	return cells
+}
+
+// refill returns a new grid with the removed cells cleared, the symbols
+// above them dropped to the bottom of each reel and the gaps at the top
+// filled. Strip fills continue up each strip from above, which they
+// advance; RNG fills draw every new symbol in one request.
+func (cfg *GameConfig) refill(matrix [][]string, removed []Position, strips [][]string, above []int, fill FillFunc) ([][]string, string, error) {
+	cleared := make(map[Position]bool, len(removed))
+	for _, p := range removed {
+		cleared[p] = true
+	}
+	rows := len(matrix)
+	next := make([][]string, rows)
+	for r := range next {
+		next[r] = make([]string, cfg.Grid.Reels)
+	}
+
+	var empty []Position
+	for reel := 0; reel < cfg.Grid.Reels; reel++ {
+		// Survivors fall to the bottom of the reel, keeping their order.
+		height := reelHeight(matrix, reel)
+		row := height - 1
+		for r := height - 1; r >= 0; r-- {
+			if !cleared[Position{Reel: reel, Row: r}] {
+				next[row][reel] = matrix[r][reel]
+				row--
+			}
+		}
+		// Rows 0..row are now empty.
+		for r := row; r >= 0; r-- {
+			if cfg.Cascade.Fill == FillRNG {
+				empty = append(empty, Position{Reel: reel, Row: r})
+				continue
+			}
+			strip := strips[reel]
+			next[r][reel] = strip[above[reel]]
+			above[reel] = (above[reel] - 1 + len(strip)) % len(strip)
+		}
+	}
+	if len(empty) == 0 {
+		return next, "", nil
+	}
+
+	symbols, weights := weightTable(cfg.Cascade.FillWeights)
+	tables := make([][]int64, len(empty))
+	for i := range tables {
+		tables[i] = weights
+	}
+	indices, auditID, err := fill(tables)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(indices) != len(empty) {
+		return nil, "", fmt.Errorf("cascade: got %d fill draws for %d cells", len(indices), len(empty))
+	}
+	for i, p := range empty {
+		if indices[i] < 0 || int(indices[i]) >= len(symbols) {
+			return nil, "", fmt.Errorf("cascade: fill index %d out of range", indices[i])
+		}
+		next[p.Row][p.Reel] = symbols[indices[i]]
+	}
+	return next, auditID, nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// cascadeConfig is a one-row, three-reel line game paying 1 for A-A-A, with
+// the given cascade settings.
+const cascadeConfig = `{
+	"game_code": "cascade", "version": "1",
+	"grid": {"rows": 1, "reels": 3},
+	"paylines": [[0, 0, 0]],
+	"paytable": {"A": {"3": 1}},
+	"cascade": %s,
+	"reel_strips": [["B", "A", "A"], ["B", "A", "A"], ["B", "A", "A"]]
+}`
+
+func TestCascade(t *testing.T) {
+	tests := []struct {
+		name    string
+		cascade string
+		stops   []int64
+		draws   [][]int64
+		wins    []int // per step
+		grids   [][]string
+	}{
+		{
+			// Strip fills continue up the strip: A, then B.
+			name:    "strip fill",
+			cascade: `{"multipliers": [1, 2, 3]}`,
+			stops:   []int64{2, 2, 2},
+			wins:    []int{1, 2, 0},
+			grids:   [][]string{{"A", "A", "A"}, {"A", "A", "A"}, {"B", "B", "B"}},
+		},
+		{
+			name:    "no win",
+			cascade: `{"multipliers": [1, 2, 3]}`,
+			stops:   []int64{0, 1, 1},
+			wins:    []int{0},
+			grids:   [][]string{{"B", "A", "A"}},
+		},
+		{
+			// RNG fill symbols are sorted: index 0 is A, 1 is B.
+			name:    "rng fill",
+			cascade: `{"multipliers": [1, 2, 3], "fill": "rng", "fill_weights": {"A": 1, "B": 1}}`,
+			stops:   []int64{1, 1, 1},
+			draws:   [][]int64{{0, 0, 0}, {0, 0, 0}, {0, 1, 0}},
+			wins:    []int{1, 2, 3, 0},
+			grids:   [][]string{{"A", "A", "A"}, {"A", "A", "A"}, {"A", "A", "A"}, {"A", "B", "A"}},
+		},
+		{
+			name:    "max steps",
+			cascade: `{"fill": "rng", "fill_weights": {"A": 1}, "max_steps": 2}`,
+			stops:   []int64{1, 1, 1},
+			draws:   [][]int64{{0, 0, 0}},
+			wins:    []int{1, 1},
+			grids:   [][]string{{"A", "A", "A"}, {"A", "A", "A"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, fmt.Sprintf(cascadeConfig, tt.cascade))
+			result, err := cfg.PerformSpin(tt.stops, 1, drawSequence(t, tt.draws...), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var wins []int
+			var grids [][]string
+			total := 0
+			for _, step := range result.Cascades {
+				wins = append(wins, step.Win)
+				grids = append(grids, step.Matrix[0])
+				total += step.Win
+			}
+			if !reflect.DeepEqual(wins, tt.wins) || !reflect.DeepEqual(grids, tt.grids) {
+				t.Errorf("steps won %v on %v, want %v on %v", wins, grids, tt.wins, tt.grids)
+			}
+			if result.TotalWin != total {
+				t.Errorf("total win %d, want %d", result.TotalWin, total)
+			}
+		})
+	}
+}
+
+func TestCascadeRefillDrops(t *testing.T) {
+	const raw = `{
+		"game_code": "cascade_drop", "version": "1",
+		"grid": {"rows": 3, "reels": 2},
+		"paylines": [[2, 2]],
+		"paytable": {"A": {"2": 1}},
+		"cascade": {},
+		"reel_strips": [["X", "Y", "B", "C", "A"], ["Z", "D", "E", "A"]]
+	}`
+	cfg := testConfig(t, raw)
+	// Reel 0 shows B C A and reel 1 E A Z; the win on the bottom row
+	// clears A and lets the cells above drop.
+	matrix := [][]string{{"B", "E"}, {"C", "A"}, {"A", "Z"}}
+	removed := []Position{{Reel: 0, Row: 2}, {Reel: 1, Row: 1}}
+	above := []int{1, 1}
+	next, _, err := cfg.refill(matrix, removed, cfg.ReelStrips, above, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"Y", "D"}, {"B", "E"}, {"C", "Z"}}
+	if !reflect.DeepEqual(next, want) {
+		t.Errorf("refilled grid %v, want %v", next, want)
+	}
+	if !reflect.DeepEqual(above, []int{0, 0}) {
+		t.Errorf("strips continue at %v, want [0 0]", above)
+	}
+}
+
+func TestValidateCascade(t *testing.T) {
+	tests := []struct {
+		name    string
+		cascade CascadeConfig
+	}{
+		{"zero multiplier", CascadeConfig{Multipliers: []int{1, 0}}},
+		{"unknown fill", CascadeConfig{Fill: "gravity"}},
+		{"rng fill without weights", CascadeConfig{Fill: FillRNG}},
+		{"negative weight", CascadeConfig{Fill: FillRNG, FillWeights: map[string]int{"A": 2, "B": -1}}},
+		{"negative max steps", CascadeConfig{MaxSteps: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cascade.validate(); err == nil {
+				t.Error("validate accepted the cascade")
+			}
+		})
+	}
+}
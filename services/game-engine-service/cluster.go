@@ -0,0 +1,77 @@
+package main
+
+import "sort"
+
+// evaluateClusters pays connected groups of at least ClusterMin cells of
+// one paytable symbol, joined horizontally or vertically. Wilds join any
+// cluster they touch, so one wild can complete clusters of several symbols
+// or link two groups of the same symbol. Groups made only of wilds do not
+// pay.
+func (cfg *GameConfig) evaluateClusters(matrix [][]string, baseBet int) []WinLine {
+	var wins []WinLine
+	for _, symbol := range cfg.payingSymbols() {
+		seen := make([][]bool, len(matrix))
+		for row := range seen {
+			seen[row] = make([]bool, cfg.Grid.Reels)
+		}
+		// Start a fill only from the symbol itself; wilds are reached
+		// through it.
+		for reel := 0; reel < cfg.Grid.Reels; reel++ {
+			for row := range matrix {
+				if seen[row][reel] || matrix[row][reel] != symbol {
+					continue
+				}
+				cluster := cfg.floodFill(matrix, seen, symbol, Position{Reel: reel, Row: row})
+				if len(cluster) < cfg.ClusterMin {
+					continue
+				}
+				if pay := payAtLeast(cfg.Paytable[symbol], len(cluster)); pay > 0 {
+					wins = append(wins, WinLine{
+						Symbol:     symbol,
+						Count:      len(cluster),
+						Positions:  cluster,
+						Multiplier: 1,
+						Payout:     pay * baseBet,
+					})
+				}
+			}
+		}
+	}
+	return wins
+}
+
+// floodFill returns the cells connected to start that hold symbol or a
+// wild, in reel order. It marks the symbol's cells in seen so each cluster
+// is reported once; wilds stay unmarked so other clusters can use them.
+func (cfg *GameConfig) floodFill(matrix [][]string, seen [][]bool, symbol string, start Position) []Position {
+	visited := map[Position]bool{start: true}
+	stack := []Position{start}
+	var cluster []Position
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		cluster = append(cluster, p)
+		if matrix[p.Row][p.Reel] == symbol {
+			seen[p.Row][p.Reel] = true
+		}
+		for _, n := range []Position{
+			{Reel: p.Reel - 1, Row: p.Row}, {Reel: p.Reel + 1, Row: p.Row},
+			{Reel: p.Reel, Row: p.Row - 1}, {Reel: p.Reel, Row: p.Row + 1},
+		} {
+			if n.Reel < 0 || n.Reel >= cfg.Grid.Reels || n.Row < 0 || n.Row >= len(matrix) || visited[n] {
+				continue
+			}
+			if s := matrix[n.Row][n.Reel]; s == symbol || cfg.isWild(s) {
+				visited[n] = true
+				stack = append(stack, n)
+			}
+		}
+	}
+	sort.Slice(cluster, func(i, j int) bool {
+		if cluster[i].Reel != cluster[j].Reel {
+			return cluster[i].Reel < cluster[j].Reel
+		}
+		return cluster[i].Row < cluster[j].Row
+	})
+	return cluster
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+const clusterConfig = `{
+	"game_code": "cluster", "version": "1", "evaluation": "cluster", "bet_multiplier": 1,
+	"cluster_min": 4,
+	"grid": {"rows": 4, "reels": 4},
+	"paytable": {"A": {"4": 2, "6": 5}, "B": {"4": 1}},
+	"wilds": ["W"],
+	"reel_strips": [["A"], ["A"], ["A"], ["A"]]
+}`
+
+// clusterWin is the part of a cluster win the tests check.
+type clusterWin struct {
+	Symbol string
+	Count  int
+	Payout int
+}
+
+func TestEvaluateClusters(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix [][]string
+		want   []clusterWin
+	}{
+		{
+			name: "square",
+			matrix: [][]string{
+				{"A", "A", "C", "C"},
+				{"A", "A", "C", "C"},
+				{"C", "C", "D", "D"},
+				{"D", "D", "C", "C"},
+			},
+			want: []clusterWin{{"A", 4, 2}},
+		},
+		{
+			// Each pay covers larger clusters up to the next entry.
+			name: "five pays as four",
+			matrix: [][]string{
+				{"A", "A", "A", "C"},
+				{"A", "A", "C", "C"},
+				{"C", "C", "D", "D"},
+				{"D", "D", "C", "C"},
+			},
+			want: []clusterWin{{"A", 5, 2}},
+		},
+		{
+			name: "six",
+			matrix: [][]string{
+				{"A", "A", "A", "C"},
+				{"A", "A", "A", "C"},
+				{"C", "C", "D", "D"},
+				{"D", "D", "C", "C"},
+			},
+			want: []clusterWin{{"A", 6, 5}},
+		},
+		{
+			name: "too small",
+			matrix: [][]string{
+				{"A", "A", "A", "C"},
+				{"C", "C", "C", "A"},
+				{"D", "D", "D", "D"},
+				{"C", "C", "C", "C"},
+			},
+		},
+		{
+			name: "diagonals do not connect",
+			matrix: [][]string{
+				{"A", "C", "A", "C"},
+				{"C", "A", "C", "A"},
+				{"A", "C", "A", "C"},
+				{"C", "A", "C", "A"},
+			},
+		},
+		{
+			name: "wild links two groups",
+			matrix: [][]string{
+				{"A", "A", "W", "A"},
+				{"C", "C", "C", "A"},
+				{"D", "D", "D", "D"},
+				{"C", "C", "C", "C"},
+			},
+			want: []clusterWin{{"A", 5, 2}},
+		},
+		{
+			name: "wild completes two symbols",
+			matrix: [][]string{
+				{"A", "A", "W", "B"},
+				{"A", "C", "B", "B"},
+				{"D", "D", "D", "D"},
+				{"C", "C", "C", "C"},
+			},
+			want: []clusterWin{{"A", 4, 2}, {"B", 4, 1}},
+		},
+		{
+			name: "two clusters of one symbol",
+			matrix: [][]string{
+				{"A", "A", "C", "A"},
+				{"A", "A", "C", "A"},
+				{"C", "C", "C", "A"},
+				{"D", "D", "C", "A"},
+			},
+			want: []clusterWin{{"A", 4, 2}, {"A", 4, 2}},
+		},
+		{
+			name: "wilds alone pay nothing",
+			matrix: [][]string{
+				{"W", "W", "W", "W"},
+				{"C", "C", "C", "C"},
+				{"D", "D", "D", "D"},
+				{"C", "C", "C", "C"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, clusterConfig)
+			var got []clusterWin
+			for _, w := range cfg.evaluateClusters(tt.matrix, 3) {
+				got = append(got, clusterWin{w.Symbol, w.Count, w.Payout / 3})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterPositions(t *testing.T) {
+	cfg := testConfig(t, clusterConfig)
+	matrix := [][]string{
+		{"C", "A", "C", "C"},
+		{"A", "W", "C", "C"},
+		{"C", "A", "C", "C"},
+		{"C", "C", "C", "C"},
+	}
+	wins := cfg.evaluateClusters(matrix, 1)
+	if len(wins) != 1 {
+		t.Fatalf("got %d wins, want 1", len(wins))
+	}
+	want := []Position{{Reel: 0, Row: 1}, {Reel: 1, Row: 0}, {Reel: 1, Row: 1}, {Reel: 1, Row: 2}}
+	if !reflect.DeepEqual(wins[0].Positions, want) {
+		t.Errorf("positions %+v, want %+v", wins[0].Positions, want)
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// FeatureRespins is the trigger feature name that starts the hold-and-spin
+// respins bonus.
+const FeatureRespins = "respins"
+
+// defaultRespins is the respins count when the config sets none.
+const defaultRespins = 3
+
+// RespinsConfig describes a hold-and-spin bonus. The coins that trigger it
+// lock in place with a cash value, and each respin spins only the open
+// cells. A respin landing a new coin resets the respins left to Respins; the
+// bonus ends when they run out or every cell holds a coin.
+type RespinsConfig struct {
+	// Coin is the cash symbol; triggers for FeatureRespins count it.
+	Coin string `json:"coin"`
+	// Respins granted at the start, unless the trigger awards a number,
+	// and again whenever a coin lands (default 3).
+	Respins int `json:"respins"`
+	// CoinValues maps a coin value, in base bets, to its weight, e.g.
+	// {"20": 60, "100": 8, "1000": 1}.
+	CoinValues map[int]int `json:"coin_values"`
+	// BlankWeight is the weight of an open cell landing no coin on a
+	// respin, drawn against the CoinValues weights.
+	BlankWeight int `json:"blank_weight"`
+	// Grand is paid, in base bets, on top of the coins when the grid fills.
+	Grand int `json:"grand"`
+}
+
+func (r *RespinsConfig) validate() error {
+	if r.Coin == "" {
+		return errors.New("coin symbol is required")
+	}
+	if r.Respins < 0 || r.BlankWeight < 0 || r.Grand < 0 {
+		return errors.New("respins, blank_weight and grand must not be negative")
+	}
+	total := 0
+	for value, w := range r.CoinValues {
+		if value <= 0 || w < 0 {
+			return fmt.Errorf("invalid coin value %d with weight %d", value, w)
+		}
+		total += w
+	}
+	if total == 0 {
+		return errors.New("coin_values need a positive weight")
+	}
+	return nil
+}
+
+// respins returns the respins granted at the start and on every new coin.
+func (r *RespinsConfig) respins() int {
+	if r.Respins == 0 {
+		return defaultRespins
+	}
+	return r.Respins
+}
+
+// coinTable returns the coin values in a stable order, with their weights as
+// an RNG weight table. With blank, index 0 of the table is an open cell
+// landing no coin and value i is at index i+1.
+func (r *RespinsConfig) coinTable(blank bool) ([]int, []int64) {
+	values, weights := weightTable(r.CoinValues)
+	if blank {
+		weights = append([]int64{int64(r.BlankWeight)}, weights...)
+	}
+	return values, weights
+}
+
+// Coin is a coin locked on the respins grid.
+type Coin struct {
+	Position Position `json:"position"`
+	Value    int      `json:"value"` // in the same units as the bet
+}
+
+// RespinsState is the progress of a respins bonus.
+type RespinsState struct {
+	Left   int    `json:"left"`
+	Played int    `json:"played"`
+	Coins  []Coin `json:"coins"` // locked coins, in reel order
+	Grand  bool   `json:"grand"` // every cell holds a coin
+	// AuditID is the RNG draw behind the latest coins.
+	AuditID string `json:"audit_id"`
+	// LastRespin is the most recent respin, returned again if the client
+	// retries it.
+	LastRespin *playedRespin `json:"last_respin,omitempty"`
+}
+
+// playedRespin is a resolved respin as returned to the client.
+type playedRespin struct {
+	RoundID string `json:"round_id"`
+	Landed  []Coin `json:"landed"`
+}
+
+// lockCoins starts the respins bonus of a round that triggered it, drawing
+// a value for every coin on the grid. It returns nil when the round did not
+// trigger the bonus.
+func (cfg *GameConfig) lockCoins(result SpinResult, betAmount int, draw FillFunc) (*RespinsState, error) {
+	r := cfg.Respins
+	if r == nil {
+		return nil, nil
+	}
+	var trigger *FeatureTrigger
+	for i, f := range result.Features {
+		if f.Feature == FeatureRespins {
+			trigger = &result.Features[i]
+		}
+	}
+	if trigger == nil {
+		return nil, nil
+	}
+	baseBet, err := cfg.BaseBet(betAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &RespinsState{Left: r.respins()}
+	if trigger.Awarded > 0 {
+		st.Left = trigger.Awarded
+	}
+	coins, auditID, err := cfg.drawCoins(trigger.Positions, false, baseBet, draw)
+	if err != nil {
+		return nil, err
+	}
+	st.AuditID = auditID
+	st.lock(coins, cfg.Grid.Rows*cfg.Grid.Reels)
+	return st, nil
+}
+
+// respin plays the next respin of st: every open cell draws a blank or a
+// coin. It returns the coins that landed.
+func (cfg *GameConfig) respin(st *RespinsState, betAmount int, draw FillFunc) ([]Coin, error) {
+	baseBet, err := cfg.BaseBet(betAmount)
+	if err != nil {
+		return nil, err
+	}
+	locked := make(map[Position]bool, len(st.Coins))
+	for _, c := range st.Coins {
+		locked[c.Position] = true
+	}
+	var open []Position
+	for reel := 0; reel < cfg.Grid.Reels; reel++ {
+		for row := 0; row < cfg.Grid.Rows; row++ {
+			if p := (Position{Reel: reel, Row: row}); !locked[p] {
+				open = append(open, p)
+			}
+		}
+	}
+	if len(open) == 0 {
+		return nil, errors.New("respins: grid is already full")
+	}
+
+	landed, auditID, err := cfg.drawCoins(open, true, baseBet, draw)
+	if err != nil {
+		return nil, err
+	}
+	st.Played++
+	st.AuditID = auditID
+	if len(landed) > 0 {
+		st.Left = cfg.Respins.respins()
+	} else {
+		st.Left--
+	}
+	st.lock(landed, cfg.Grid.Rows*cfg.Grid.Reels)
+	return landed, nil
+}
+
+// drawCoins draws a coin value for each of positions from one RNG draw.
+// With blank, cells may also land no coin and are left out of the result.
+func (cfg *GameConfig) drawCoins(positions []Position, blank bool, baseBet int, draw FillFunc) ([]Coin, string, error) {
+	if len(positions) == 0 {
+		return nil, "", nil
+	}
+	values, weights := cfg.Respins.coinTable(blank)
+	tables := make([][]int64, len(positions))
+	for i := range tables {
+		tables[i] = weights
+	}
+	indices, auditID, err := draw(tables)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(indices) != len(positions) {
+		return nil, "", fmt.Errorf("respins: got %d coin draws for %d cells", len(indices), len(positions))
+	}
+
+	var coins []Coin
+	for i, p := range positions {
+		idx := int(indices[i])
+		if blank {
+			if idx == 0 {
+				continue
+			}
+			idx--
+		}
+		if idx < 0 || idx >= len(values) {
+			return nil, "", fmt.Errorf("respins: coin index %d out of range", indices[i])
+		}
+		coins = append(coins, Coin{Position: p, Value: values[idx] * baseBet})
+	}
+	return coins, auditID, nil
+}
+
+// lock adds coins to the grid, ending the bonus when all cells are filled.
+func (st *RespinsState) lock(coins []Coin, cells int) {
+	st.Coins = append(st.Coins, coins...)
+	sort.Slice(st.Coins, func(i, j int) bool {
+		a, b := st.Coins[i].Position, st.Coins[j].Position
+		if a.Reel != b.Reel {
+			return a.Reel < b.Reel
+		}
+		return a.Row < b.Row
+	})
+	if len(st.Coins) >= cells {
+		st.Grand = true
+		st.Left = 0
+	}
+}
+
+// respinsWin returns the bonus win so far: the locked coins, plus the grand
+// prize once the grid is full.
+func (cfg *GameConfig) respinsWin(st *RespinsState, betAmount int) int {
+	win := 0
+	for _, c := range st.Coins {
+		win += c.Value
+	}
+	if st.Grand {
+		if baseBet, err := cfg.BaseBet(betAmount); err == nil {
+			win += cfg.Respins.Grand * baseBet
+		}
+	}
+	return win
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"testing"
+)
+
+// respinsConfig is a 2x2 line game whose coins are worth 1 or 5 base bets;
+// respin draws pick index 0 for a blank, 1 for a 1 and 2 for a 5.
+const respinsConfig = `{
+	"game_code": "respins", "version": "1",
+	"grid": {"rows": 2, "reels": 2},
+	"paylines": [[0, 0]],
+	"paytable": {"A": {"2": 1}},
+	"respins": {"coin": "C", "respins": 2, "coin_values": {"1": 1, "5": 1}, "blank_weight": 2, "grand": 10},
+	"triggers": [{"feature": "respins", "symbol": "C", "min_count": 2, "award": 0}],
+	"reel_strips": [["C", "X", "X"], ["C", "X", "X"]]
+}`
+
+func TestRespins(t *testing.T) {
+	cfg := testConfig(t, respinsConfig)
+	// Coins land on the top row and lock with a 1 and a 5.
+	result, err := cfg.PerformSpin([]int64{0, 0}, 2, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := cfg.lockCoins(result, 2, drawSequence(t, []int64{0, 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st == nil || len(st.Coins) != 2 || st.Left != 2 {
+		t.Fatalf("locked %+v, want 2 coins and 2 respins", st)
+	}
+	if win := cfg.respinsWin(st, 2); win != 12 {
+		t.Errorf("win after lock %d, want 12", win)
+	}
+
+	steps := []struct {
+		name   string
+		draw   []int64
+		landed int
+		left   int
+		win    int
+	}{
+		{"blank", []int64{0, 0}, 0, 1, 12},
+		{"coin resets the respins", []int64{2, 0}, 1, 2, 22},
+		// The grid fills: the grand prize is paid and the bonus ends.
+		{"grid full", []int64{1}, 1, 0, 24 + 20},
+	}
+	for i, step := range steps {
+		landed, err := cfg.respin(st, 2, drawSequence(t, step.draw))
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if len(landed) != step.landed || st.Left != step.left || st.Played != i+1 {
+			t.Errorf("%s: landed %d with %d left after %d, want %d with %d left", step.name, len(landed), st.Left, st.Played, step.landed, step.left)
+		}
+		if win := cfg.respinsWin(st, 2); win != step.win {
+			t.Errorf("%s: win %d, want %d", step.name, win, step.win)
+		}
+	}
+	if !st.Grand {
+		t.Error("full grid not marked grand")
+	}
+	if _, err := cfg.respin(st, 2, nil); err == nil {
+		t.Error("respin on a full grid succeeded")
+	}
+}
+
+func TestRespinsRunOut(t *testing.T) {
+	cfg := testConfig(t, respinsConfig)
+	st := &RespinsState{Left: 2, Coins: []Coin{{Position: Position{Reel: 0, Row: 0}, Value: 1}}}
+	for _, left := range []int{1, 0} {
+		if _, err := cfg.respin(st, 1, drawSequence(t, []int64{0, 0, 0})); err != nil {
+			t.Fatal(err)
+		}
+		if st.Left != left {
+			t.Errorf("%d respins left, want %d", st.Left, left)
+		}
+	}
+	if st.Grand || cfg.respinsWin(st, 1) != 1 {
+		t.Errorf("bonus ended grand %t winning %d, want 1 without the grand", st.Grand, cfg.respinsWin(st, 1))
+	}
+}
+
+func TestLockCoinsWithoutTrigger(t *testing.T) {
+	cfg := testConfig(t, respinsConfig)
+	result, err := cfg.PerformSpin([]int64{1, 1}, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := cfg.lockCoins(result, 1, nil)
+	if err != nil || st != nil {
+		t.Errorf("lockCoins = %+v, %v; want no bonus", st, err)
+	}
+}
+
+func TestValidateRespins(t *testing.T) {
+	tests := []struct {
+		name    string
+		respins RespinsConfig
+	}{
+		{"no coin", RespinsConfig{CoinValues: map[int]int{1: 1}}},
+		{"negative respins", RespinsConfig{Coin: "C", Respins: -1, CoinValues: map[int]int{1: 1}}},
+		{"zero value", RespinsConfig{Coin: "C", CoinValues: map[int]int{0: 1}}},
+		{"no weight", RespinsConfig{Coin: "C", CoinValues: map[int]int{1: 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.respins.validate(); err == nil {
+				t.Error("validate accepted the respins")
+			}
+		})
+	}
+}
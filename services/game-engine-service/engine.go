@@ -1,90 +1,539 @@
-package main
-
-import (
-	"encoding/json"
-	"io/ioutil"
-	"log"
-)
-
-// Simplified structures matching config/aurora_star.json
-type GameConfig struct {
-	GameCode string `json:"game_code"`
-	Grid     struct {
-		Rows  int `json:"rows"`
-		Reels int `json:"reels"`
-	} `json:"grid"`
-	Paylines [][]int `json:"paylines"`
-	// ReelStrips and other fields are loaded here
-	ReelStrips [][]string `json:"reel_strips"`
-}
-
-var loadedConfig GameConfig // Global or cached config
-
-func LoadGameConfig(path string) error {
-	data, err := ioutil.ReadFile(path)
-	if err != nil {
-		return err
-	}
-	return json.Unmarshal(data, &loadedConfig)
-}
-
-// SpinResult holds the outcome of a game round
-type SpinResult struct {
-	Matrix [][]string `json:"matrix"`
-	TotalWin int `json:"total_win"`
-	WinLines []string `json:"win_lines"` // Simplified win detail
-}
-
-// PerformSpin simulates the spin and win evaluation.
-// rngOutputs are the stop indices received from the RNG service.
-func PerformSpin(rngOutputs []int64, betAmount int) SpinResult {
-	if len(rngOutputs) != loadedConfig.Grid.Reels {
-		log.Fatal("RNG output count mismatch with reel count")
-	}
-
-	resultMatrix := make([][]string, loadedConfig.Grid.Reels)
-	
-	// 1. Determine Stop Positions and Reel Matrix
-	for i := 0; i < loadedConfig.Grid.Reels; i++ {
-		strip := loadedConfig.ReelStrips[i]
-		stopIndex := int(rngOutputs[i]) % len(strip)
-		
-		// Extract the visible window (3 symbols)
-		resultMatrix[i] = make([]string, loadedConfig.Grid.Rows)
-		for j := 0; j < loadedConfig.Grid.Rows; j++ {
-			// Calculate index with wrap-around logic
-			symbolIndex := (stopIndex + j) % len(strip)
-			resultMatrix[i][j] = strip[symbolIndex]
-		}
-	}
-	
-	// Transpose the matrix for easier evaluation (Reels x Rows -> Rows x Reels)
-	finalMatrix := make([][]string, loadedConfig.Grid.Rows)
-	for r := 0; r < loadedConfig.Grid.Rows; r++ {
-		finalMatrix[r] = make([]string, loadedConfig.Grid.Reels)
-		for c := 0; c < loadedConfig.Grid.Reels; c++ {
-			finalMatrix[r][c] = resultMatrix[c][r]
-		}
-	}
-
-
-	// 2. Win Evaluation (Highly simplified, full logic is complex)
-	totalWin := 0
-	winLines := []string{}
-	// For Aurora Star (20 Paylines), iterate through paylines to check matches
-	// ... actual win calculation based on paytable and line matches ...
-	
-	// Placeholder: Award a simple win if the middle symbol on reel 3 is the top symbol
-	if finalMatrix[1][2] == "S_HIGH_A" {
-	    totalWin = betAmount * 5
-	    winLines = append(winLines, "CENTER_MATCH")
-	}
-
-	log.Printf("Spin resolved. Matrix: %v, Win: %d", finalMatrix, totalWin)
-	
-	return SpinResult{
-		Matrix:   finalMatrix,
-		TotalWin: totalWin,
-		WinLines: winLines,
-	}
-}
\ No newline at end of file
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+// Evaluation modes for GameConfig.Evaluation.
+const (
+	EvalLines   = "lines"   // pays along the configured paylines (default)
+	EvalWays    = "ways"    // pays adjacent-reel matches anywhere in each reel
+	EvalCluster = "cluster" // pays connected clusters of identical symbols
+)
+
+// Simplified structures matching config/aurora_star.json
+type GameConfig struct {
+	GameCode string `json:"game_code"`
+	// Version identifies this revision of the game's maths and is reported
+	// with every round.
+	Version string `json:"version"`
+	Grid    struct {
+		Rows  int `json:"rows"`
+		Reels int `json:"reels"`
+		// Heights makes reel heights variable (Megaways-style): every spin
+		// draws each reel's height from these weights, keyed by height,
+		// e.g. {"2": 1, "7": 3}. Rows is then the tallest reel.
+		Heights map[int]int `json:"heights"`
+	} `json:"grid"`
+	// Evaluation selects how wins are found: EvalLines, EvalWays or
+	// EvalCluster.
+	Evaluation string  `json:"evaluation"`
+	Paylines   [][]int `json:"paylines"`
+	// BetMultiplier divides the bet into the base bet for ways and cluster
+	// games, e.g. 25 for a 243-ways game staked in multiples of 25.
+	BetMultiplier int `json:"bet_multiplier"`
+	// ClusterMin is the smallest cluster that pays in cluster games.
+	ClusterMin int `json:"cluster_min"`
+	// Paytable maps a symbol to its pay, in base bets, by the number of
+	// matching symbols from the leftmost reel, e.g. {"S_HIGH_A": {"3": 20}}.
+	// In cluster games it is keyed by cluster size, and each entry also
+	// covers larger clusters up to the next one ("12" pays 12+).
+	Paytable map[string]map[int]int `json:"paytable"`
+	// Wilds substitute for any symbol in the paytable. In line games a wild
+	// listed in the paytable also pays for runs made only of wilds.
+	Wilds []string `json:"wilds"`
+	// WildFeatures adds expanding, sticky, walking or multiplier behaviour
+	// to wilds, keyed by wild symbol.
+	WildFeatures map[string]WildFeature `json:"wild_features"`
+	// WildMultipliers combines the multiplier wilds in one win: WildsAdd or
+	// WildsMultiply.
+	WildMultipliers string `json:"wild_multipliers"`
+	// Scatters maps a scatter symbol to its pay, in total bets, by the number
+	// landing anywhere on the grid; each entry also covers larger counts up
+	// to the next. Scatters are never substituted by wilds.
+	Scatters map[string]map[int]int `json:"scatters"`
+	// Triggers award features when enough of a symbol lands anywhere.
+	Triggers []TriggerRule `json:"triggers"`
+	// Cascade makes winning symbols clear and refill until no wins remain.
+	Cascade *CascadeConfig `json:"cascade"`
+	// FreeSpins configures the free spins feature, awarded by triggers for
+	// FeatureFreeSpins.
+	FreeSpins *FreeSpinsConfig `json:"free_spins"`
+	// Mystery configures mystery symbols, revealed before evaluation.
+	Mystery *MysteryConfig `json:"mystery"`
+	// Respins configures the hold-and-spin bonus, awarded by triggers for
+	// FeatureRespins.
+	Respins *RespinsConfig `json:"respins"`
+	// ReelStrips and other fields are loaded here
+	ReelStrips [][]string `json:"reel_strips"`
+}
+
+// FeatureFreeSpins is the trigger feature name that starts free spins.
+const FeatureFreeSpins = "free_spins"
+
+// FreeSpinsConfig describes a game's free spins feature.
+type FreeSpinsConfig struct {
+	// ReelStrips used during free spins; the base game strips when empty.
+	ReelStrips [][]string `json:"reel_strips"`
+	// Multiplier applied to every free spin win (default 1).
+	Multiplier int `json:"multiplier"`
+	// Retrigger lets free spins triggers during the feature add their award.
+	Retrigger bool `json:"retrigger"`
+	// MaxSpins caps the spins one feature can award, 0 for no cap.
+	MaxSpins int `json:"max_spins"`
+}
+
+// TriggerRule awards a feature when at least MinCount of Symbol land
+// anywhere on the grid, e.g. 3+ S_SCATTER award 10 free spins. When several
+// rules for one feature match, the one with the highest MinCount applies.
+type TriggerRule struct {
+	Feature  string `json:"feature"`
+	Symbol   string `json:"symbol"`
+	MinCount int    `json:"min_count"`
+	Award    int    `json:"award"`
+}
+
+// LoadGameConfig reads and validates a game configuration file.
+func LoadGameConfig(path string) (*GameConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &GameConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// validate checks that the grid, reel strips and paylines agree, so a spin
+// can never index outside them.
+func (cfg *GameConfig) validate() error {
+	if cfg.GameCode == "" {
+		return errors.New("game_code is required")
+	}
+	if cfg.Grid.Rows <= 0 || cfg.Grid.Reels <= 0 {
+		return fmt.Errorf("grid must have positive rows and reels, got %dx%d", cfg.Grid.Rows, cfg.Grid.Reels)
+	}
+	if err := cfg.validateStrips(cfg.ReelStrips); err != nil {
+		return err
+	}
+	if c := cfg.Cascade; c != nil {
+		if err := c.validate(); err != nil {
+			return fmt.Errorf("cascade: %w", err)
+		}
+	}
+	if fs := cfg.FreeSpins; fs != nil {
+		if len(fs.ReelStrips) > 0 {
+			if err := cfg.validateStrips(fs.ReelStrips); err != nil {
+				return fmt.Errorf("free spins: %w", err)
+			}
+		}
+		if fs.Multiplier < 0 || fs.MaxSpins < 0 {
+			return errors.New("free spins: multiplier and max_spins must not be negative")
+		}
+	}
+	if r := cfg.Respins; r != nil {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("respins: %w", err)
+		}
+		if len(cfg.Grid.Heights) > 0 {
+			return errors.New("respins: need a fixed grid, not variable reel heights")
+		}
+	}
+	if err := cfg.validateHeights(); err != nil {
+		return err
+	}
+	if err := cfg.validateWilds(); err != nil {
+		return err
+	}
+	if cfg.Mystery != nil {
+		if err := cfg.validateMystery(); err != nil {
+			return fmt.Errorf("mystery: %w", err)
+		}
+	}
+	maxCount := cfg.Grid.Reels
+	switch cfg.Evaluation {
+	case "", EvalLines:
+	case EvalWays, EvalCluster:
+		if cfg.BetMultiplier <= 0 {
+			return fmt.Errorf("%s games need a positive bet_multiplier", cfg.Evaluation)
+		}
+		if cfg.Evaluation == EvalCluster {
+			if cfg.ClusterMin < 2 {
+				return errors.New("cluster games need a cluster_min of at least 2")
+			}
+			maxCount = cfg.Grid.Rows * cfg.Grid.Reels
+		}
+	default:
+		return fmt.Errorf("unknown evaluation %q", cfg.Evaluation)
+	}
+	for i, line := range cfg.Paylines {
+		if len(line) != cfg.Grid.Reels {
+			return fmt.Errorf("payline %d has %d positions for %d reels", i+1, len(line), cfg.Grid.Reels)
+		}
+		for _, row := range line {
+			if row < 0 || row >= cfg.Grid.Rows {
+				return fmt.Errorf("payline %d row %d out of range", i+1, row)
+			}
+		}
+	}
+	for symbol, pays := range cfg.Paytable {
+		for count, pay := range pays {
+			if count < 1 || count > maxCount {
+				return fmt.Errorf("paytable %s: count %d out of range", symbol, count)
+			}
+			if pay < 0 {
+				return fmt.Errorf("paytable %s: negative pay for %d", symbol, count)
+			}
+		}
+	}
+	for symbol, pays := range cfg.Scatters {
+		if _, ok := cfg.Paytable[symbol]; ok || cfg.isWild(symbol) {
+			return fmt.Errorf("scatter %s is also a paying or wild symbol", symbol)
+		}
+		for count, pay := range pays {
+			if count < 1 || count > cfg.Grid.Rows*cfg.Grid.Reels || pay < 0 {
+				return fmt.Errorf("scatter %s: invalid pay %d for %d", symbol, pay, count)
+			}
+		}
+	}
+	for i, rule := range cfg.Triggers {
+		if rule.Feature == "" || rule.Symbol == "" || rule.MinCount < 1 || rule.Award < 0 {
+			return fmt.Errorf("trigger %d: feature, symbol and a positive min_count are required", i)
+		}
+		if rule.Feature == FeatureRespins && cfg.Respins != nil && rule.Symbol != cfg.Respins.Coin {
+			return fmt.Errorf("trigger %d: respins must trigger on the coin symbol %s", i, cfg.Respins.Coin)
+		}
+	}
+	return nil
+}
+
+func (cfg *GameConfig) validateStrips(strips [][]string) error {
+	if len(strips) != cfg.Grid.Reels {
+		return fmt.Errorf("%d reel strips for %d reels", len(strips), cfg.Grid.Reels)
+	}
+	for i, strip := range strips {
+		if len(strip) == 0 {
+			return fmt.Errorf("reel strip %d is empty", i)
+		}
+	}
+	return nil
+}
+
+// payAtLeast returns the pay for n from pays keyed by count: the entry for
+// the largest count not above n, or 0.
+func payAtLeast(pays map[int]int, n int) int {
+	best, pay := 0, 0
+	for count, p := range pays {
+		if count <= n && count > best {
+			best, pay = count, p
+		}
+	}
+	return pay
+}
+
+// isWild reports whether symbol is one of the game's wilds.
+func (cfg *GameConfig) isWild(symbol string) bool {
+	for _, w := range cfg.Wilds {
+		if symbol == w {
+			return true
+		}
+	}
+	return false
+}
+
+// BaseBet splits a round's bet into the unit the paytable pays in: the
+// line bet (bet / paylines) for line games, or bet / bet_multiplier for
+// ways and cluster games.
+func (cfg *GameConfig) BaseBet(betAmount int) (int, error) {
+	units := cfg.BetMultiplier
+	if cfg.Evaluation == "" || cfg.Evaluation == EvalLines {
+		units = len(cfg.Paylines)
+		if units == 0 {
+			return 0, errors.New("game has no paylines")
+		}
+	}
+	if betAmount <= 0 || betAmount%units != 0 {
+		return 0, fmt.Errorf("bet %d is not a positive multiple of %d", betAmount, units)
+	}
+	return betAmount / units, nil
+}
+
+// ReelBounds returns the strip length of each reel, in reel order.
+// These are sent to the RNG service as per-draw bounds so every stop index
+// is drawn uniformly from its own strip. Variable-height games add one
+// draw per reel bounded by the total height weight, which picks that
+// reel's height.
+func (cfg *GameConfig) ReelBounds() []int64 {
+	return cfg.reelBounds(cfg.ReelStrips)
+}
+
+// FreeSpinReelBounds is ReelBounds for the free spins reel set.
+func (cfg *GameConfig) FreeSpinReelBounds() []int64 {
+	return cfg.reelBounds(cfg.freeSpinStrips())
+}
+
+func (cfg *GameConfig) reelBounds(strips [][]string) []int64 {
+	bounds := make([]int64, len(strips))
+	for i, strip := range strips {
+		bounds[i] = int64(len(strip))
+	}
+	if total := cfg.heightWeightTotal(); total > 0 {
+		for range strips {
+			bounds = append(bounds, int64(total))
+		}
+	}
+	return bounds
+}
+
+func (cfg *GameConfig) freeSpinStrips() [][]string {
+	if cfg.FreeSpins != nil && len(cfg.FreeSpins.ReelStrips) > 0 {
+		return cfg.FreeSpins.ReelStrips
+	}
+	return cfg.ReelStrips
+}
+
+// Position is a cell on the grid: reels count from 0 on the left, rows from
+// 0 at the top.
+type Position struct {
+	Reel int `json:"reel"`
+	Row  int `json:"row"`
+}
+
+// WinLine is a single win on a payline, all the ways of one symbol in a
+// ways game, or one cluster in a cluster game.
+type WinLine struct {
+	LineID     int        `json:"line_id"` // 1-based payline id, 0 for ways, cluster and scatter wins
+	Symbol     string     `json:"symbol"`
+	Count      int        `json:"count"` // symbols on the line, reels for ways, cluster size
+	Positions  []Position `json:"positions"`
+	Multiplier int        `json:"multiplier"` // applied to the paytable pay, 1 if none; ways wins fold wild multipliers into Payout
+	Payout     int        `json:"payout"`
+	Ways       int        `json:"ways,omitempty"` // number of ways, ways games only
+}
+
+// FeatureTrigger records a feature triggered by a round, e.g. free spins.
+type FeatureTrigger struct {
+	Feature   string     `json:"feature"`
+	Symbol    string     `json:"symbol"`
+	Positions []Position `json:"positions"`
+	Awarded   int        `json:"awarded"` // e.g. free spins awarded
+}
+
+// SpinResult holds the outcome of a game round
+type SpinResult struct {
+	Matrix   [][]string       `json:"matrix"` // rows x reels
+	Stops    []int64          `json:"stops"`
+	TotalWin int              `json:"total_win"`
+	WinLines []WinLine        `json:"win_lines"`
+	Features []FeatureTrigger `json:"features"`
+	// ScatterPositions lists every cell holding a scatter, paying or not.
+	ScatterPositions []Position `json:"scatter_positions"`
+	// Cascades holds every step of a cascading round, starting with Matrix;
+	// WinLines then holds the wins of all steps.
+	Cascades []CascadeStep `json:"cascades,omitempty"`
+	// Heights is the height of each reel this spin.
+	Heights []int `json:"heights"`
+	// Expanded lists the reels filled by expanding wilds; Matrix shows
+	// them expanded.
+	Expanded []int `json:"expanded,omitempty"`
+	// WildRespins holds the respins awarded by walking wilds, in order;
+	// WinLines then holds their wins too.
+	WildRespins []WildRespin `json:"wild_respins,omitempty"`
+	// Sticky holds every sticky wild after a free spin, to be held on the
+	// next one.
+	Sticky []WildCell `json:"sticky,omitempty"`
+	// PreRevealMatrix is the grid as it stopped, before mystery symbols
+	// were revealed; set only when Mystery is.
+	PreRevealMatrix [][]string     `json:"pre_reveal_matrix,omitempty"`
+	Mystery         *MysteryReveal `json:"mystery,omitempty"`
+}
+
+// spinMode holds what differs between base and free spins.
+type spinMode struct {
+	strips     [][]string
+	multiplier int
+	held       []WildCell     // sticky wilds placed before evaluation
+	sticky     bool           // sticky wilds landing are held, i.e. in free spins
+	mystery    map[string]int // weights of the symbol mysteries reveal
+}
+
+// DrawFunc draws one number in [0, bound) per bound from the RNG service and
+// returns them with the draw's audit id. Walking wild respins use it to draw
+// their stops, as the base spin draws its own.
+type DrawFunc func(bounds []int64) ([]int64, string, error)
+
+// PerformSpin simulates the spin and win evaluation.
+// rngOutputs are the numbers received from the RNG service for ReelBounds:
+// one stop index per reel, each already drawn in [0, len(strip)), followed
+// for variable-height games by one height draw per reel.
+// fill supplies weighted draws for cascades with an RNG fill and mystery
+// reveals, and draw the stops of walking wild respins; either may be nil
+// for games without those features.
+func (cfg *GameConfig) PerformSpin(rngOutputs []int64, betAmount int, fill FillFunc, draw DrawFunc) (SpinResult, error) {
+	mode := spinMode{strips: cfg.ReelStrips, multiplier: 1, mystery: cfg.mysteryWeights(false)}
+	return cfg.spin(mode, rngOutputs, betAmount, fill, draw)
+}
+
+// PerformFreeSpin plays one free spin on the free spins reel set, with
+// rngOutputs drawn using FreeSpinReelBounds. Every win is multiplied by the
+// feature's multiplier. sticky holds the sticky wilds from earlier free
+// spins; the result's Sticky adds those landing on this one.
+func (cfg *GameConfig) PerformFreeSpin(rngOutputs []int64, betAmount int, sticky []WildCell, fill FillFunc, draw DrawFunc) (SpinResult, error) {
+	multiplier := 1
+	if cfg.FreeSpins != nil && cfg.FreeSpins.Multiplier > 0 {
+		multiplier = cfg.FreeSpins.Multiplier
+	}
+	mode := spinMode{
+		strips:     cfg.freeSpinStrips(),
+		multiplier: multiplier,
+		held:       sticky,
+		sticky:     true,
+		mystery:    cfg.mysteryWeights(true),
+	}
+	result, err := cfg.spin(mode, rngOutputs, betAmount, fill, draw)
+	if err != nil {
+		return result, err
+	}
+	result.Sticky = cfg.stickyWilds(result.Matrix)
+	return result, nil
+}
+
+func (cfg *GameConfig) spin(mode spinMode, rngOutputs []int64, betAmount int, fill FillFunc, draw DrawFunc) (SpinResult, error) {
+	strips, multiplier := mode.strips, mode.multiplier
+	heights, err := cfg.reelHeights(rngOutputs)
+	if err != nil {
+		return SpinResult{}, err
+	}
+	rngOutputs = rngOutputs[:cfg.Grid.Reels]
+
+	// 1. Determine Stop Positions and Reel Matrix
+	finalMatrix, err := cfg.window(strips, rngOutputs, heights)
+	if err != nil {
+		return SpinResult{}, err
+	}
+	// Mystery reveals and wild behaviours apply before evaluation.
+	placeWilds(finalMatrix, mode.held)
+	preReveal := copyMatrix(finalMatrix)
+	mystery, err := cfg.reveal(finalMatrix, mode.mystery, fill)
+	if err != nil {
+		return SpinResult{}, err
+	}
+	if mystery == nil {
+		preReveal = nil
+	}
+	expanded := cfg.expandWilds(finalMatrix)
+
+	// 2. Win Evaluation
+	baseBet, err := cfg.BaseBet(betAmount)
+	if err != nil {
+		return SpinResult{}, err
+	}
+	var winLines []WinLine
+	var cascades []CascadeStep
+	evalMatrix := finalMatrix
+	if cfg.Cascade != nil {
+		cascades, err = cfg.cascade(strips, rngOutputs, finalMatrix, baseBet, multiplier, fill)
+		if err != nil {
+			return SpinResult{}, err
+		}
+		for _, step := range cascades {
+			winLines = append(winLines, step.WinLines...)
+		}
+		// Scatters and triggers count on the grid the cascades settle on.
+		evalMatrix = cascades[len(cascades)-1].Matrix
+	} else {
+		winLines = applyMultiplier(cfg.evaluateWins(finalMatrix, baseBet), multiplier)
+	}
+	scatterWins, scatterPositions := cfg.evaluateScatters(evalMatrix, betAmount)
+	winLines = append(winLines, applyMultiplier(scatterWins, multiplier)...)
+	// Walking wilds respin after the round; scatters and triggers do not
+	// count on their grids. Sticky wilds, including those landing now, are
+	// held through the respins.
+	if mode.sticky {
+		mode.held = cfg.stickyWilds(finalMatrix)
+	}
+	respins, err := cfg.walk(mode, heights, finalMatrix, baseBet, fill, draw)
+	if err != nil {
+		return SpinResult{}, err
+	}
+	for _, rs := range respins {
+		winLines = append(winLines, rs.WinLines...)
+	}
+	totalWin := 0
+	for _, w := range winLines {
+		totalWin += w.Payout
+	}
+	features := cfg.evaluateTriggers(evalMatrix)
+
+	log.Printf("Spin resolved. Matrix: %v, Win: %d", finalMatrix, totalWin)
+
+	return SpinResult{
+		Matrix:           finalMatrix,
+		Stops:            rngOutputs,
+		TotalWin:         totalWin,
+		WinLines:         winLines,
+		Features:         features,
+		ScatterPositions: scatterPositions,
+		Cascades:         cascades,
+		Heights:          heights,
+		Expanded:         expanded,
+		WildRespins:      respins,
+		PreRevealMatrix:  preReveal,
+		Mystery:          mystery,
+	}, nil
+}
+
+// window returns the rows x reels grid showing at stops on strips. Rows
+// below a shorter reel's height stay empty.
+func (cfg *GameConfig) window(strips [][]string, stops []int64, heights []int) ([][]string, error) {
+	matrix := make([][]string, cfg.Grid.Rows)
+	for r := range matrix {
+		matrix[r] = make([]string, cfg.Grid.Reels)
+	}
+	for reel := 0; reel < cfg.Grid.Reels; reel++ {
+		strip := strips[reel]
+		stop := int(stops[reel])
+		if stop < 0 || stop >= len(strip) {
+			return nil, fmt.Errorf("RNG stop index %d out of range for reel %d (length %d)", stop, reel, len(strip))
+		}
+		for row := 0; row < heights[reel]; row++ {
+			// Wrap around the end of the strip.
+			matrix[row][reel] = strip[(stop+row)%len(strip)]
+		}
+	}
+	return matrix, nil
+}
+
+// evaluateWins finds the wins on matrix using the game's evaluation mode.
+// Scatters are evaluated separately.
+// Multiplier wilds apply to every win they are part of.
+func (cfg *GameConfig) evaluateWins(matrix [][]string, baseBet int) []WinLine {
+	var wins []WinLine
+	switch cfg.Evaluation {
+	case EvalWays:
+		wins = cfg.evaluateWays(matrix, baseBet)
+	case EvalCluster:
+		wins = cfg.evaluateClusters(matrix, baseBet)
+	default:
+		wins = cfg.evaluateLines(matrix, baseBet)
+	}
+	return cfg.applyWildMultipliers(matrix, wins)
+}
+
+// applyMultiplier multiplies each win's pay by m in place and returns wins.
+func applyMultiplier(wins []WinLine, m int) []WinLine {
+	for i := range wins {
+		wins[i].Multiplier *= m
+		wins[i].Payout *= m
+	}
+	return wins
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
+	pb_rng "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// freeSpinsAward returns the free spins result awards, or 0.
+func freeSpinsAward(result SpinResult) int {
+	for _, f := range result.Features {
+		if f.Feature == FeatureFreeSpins {
+			return f.Awarded
+		}
+	}
+	return 0
+}
+
+// capSpins limits a feature's total award to the configured maximum.
+func (fs *FreeSpinsConfig) capSpins(spins int) int {
+	if fs.MaxSpins > 0 && spins > fs.MaxSpins {
+		return fs.MaxSpins
+	}
+	return spins
+}
+
+// startFreeSpins creates and persists the free spins feature a base round
+// triggered. It returns nil when the round awarded none.
+func (s *engineServer) startFreeSpins(cfg *GameConfig, playerID, roundID string, betAmount int, result SpinResult) (*FeatureSession, error) {
+	award := freeSpinsAward(result)
+	if cfg.FreeSpins == nil || award <= 0 {
+		return nil, nil
+	}
+	id, err := newRoundID()
+	if err != nil {
+		return nil, err
+	}
+	multiplier := cfg.FreeSpins.Multiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	if err := s.sessions.pinConfig(cfg); err != nil {
+		return nil, err
+	}
+	sess := &FeatureSession{
+		ID:            id,
+		Feature:       FeatureFreeSpins,
+		GameCode:      cfg.GameCode,
+		ConfigVersion: cfg.Version,
+		PlayerID:      playerID,
+		RoundID:       roundID,
+		BetAmount:     betAmount,
+		SpinsAwarded:  cfg.FreeSpins.capSpins(award),
+		Multiplier:    multiplier,
+	}
+	if err := s.sessions.save(sess); err != nil {
+		return nil, err
+	}
+	log.Printf("Round %s awarded %d free spins (session %s)", roundID, sess.SpinsAwarded, sess.ID)
+	return sess, nil
+}
+
+func (s *engineServer) FreeSpin(ctx context.Context, req *pb_engine.FreeSpinRequest) (*pb_engine.SpinResponse, error) {
+	unlock := s.sessions.lock(req.GetSessionId())
+	defer unlock()
+
+	sess, err := s.loadSession(req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	if sess.Feature == FeatureRespins {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is not a free spins session", sess.ID)
+	}
+	cfg, err := s.sessionConfig(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	index := int(req.GetSpinIndex())
+	if last := sess.LastSpin; last != nil && index == sess.SpinsPlayed-1 {
+		resp := toSpinResponse(cfg, last.RoundID, last.AuditID, last.Result)
+		resp.FreeSpins = toFreeSpinsState(sess)
+		return resp, nil
+	}
+	if sess.Completed {
+		return nil, status.Errorf(codes.FailedPrecondition, "free spins session %s is complete", sess.ID)
+	}
+	if index != sess.SpinsPlayed {
+		return nil, status.Errorf(codes.FailedPrecondition, "next spin_index is %d, got %d", sess.SpinsPlayed, index)
+	}
+
+	roundID := fmt.Sprintf("%s-fs%d", sess.RoundID, sess.SpinsPlayed+1)
+	rngResp, err := s.rngClient.GetNumbers(ctx, &pb_rng.RNGRequest{
+		Bounds:  cfg.FreeSpinReelBounds(),
+		Caller:  "game-engine-service",
+		RoundId: roundID,
+		Stream:  cfg.GameCode,
+	})
+	if err != nil {
+		log.Printf("Error calling RNG: %v", err)
+		return nil, err
+	}
+	result, err := cfg.PerformFreeSpin(rngResp.GetNumbers(), sess.BetAmount, sess.Sticky, s.rngFill(ctx, cfg, roundID), s.rngDraw(ctx, cfg, roundID))
+	if err != nil {
+		log.Printf("Round %s failed to resolve: %v", roundID, err)
+		return nil, status.Error(codes.Internal, "failed to resolve spin")
+	}
+
+	if award := freeSpinsAward(result); award > 0 {
+		if cfg.FreeSpins.Retrigger {
+			sess.SpinsAwarded = cfg.FreeSpins.capSpins(sess.SpinsAwarded + award)
+		} else {
+			// Only report what was actually awarded.
+			result.Features = withoutFeature(result.Features, FeatureFreeSpins)
+		}
+	}
+	// A player plays one feature at a time; respins do not start from free spins.
+	result.Features = withoutFeature(result.Features, FeatureRespins)
+	sess.SpinsPlayed++
+	sess.Sticky = result.Sticky
+	sess.TotalWin += result.TotalWin
+	sess.Completed = sess.SpinsPlayed >= sess.SpinsAwarded
+	sess.LastSpin = &playedSpin{RoundID: roundID, AuditID: rngResp.GetAuditId(), Result: result}
+	if err := s.sessions.save(sess); err != nil {
+		log.Printf("Round %s: failed to save session %s: %v", roundID, sess.ID, err)
+		return nil, status.Error(codes.Internal, "failed to save free spins state")
+	}
+	if sess.Completed {
+		log.Printf("Free spins session %s complete, total win %d", sess.ID, sess.TotalWin)
+	}
+
+	resp := toSpinResponse(cfg, roundID, rngResp.GetAuditId(), result)
+	resp.FreeSpins = toFreeSpinsState(sess)
+	return resp, nil
+}
+
+func (s *engineServer) GetFreeSpins(ctx context.Context, req *pb_engine.GetFreeSpinsRequest) (*pb_engine.FreeSpinsState, error) {
+	id := req.GetSessionId()
+	if id == "" {
+		if req.GetPlayerId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "session_id or player_id is required")
+		}
+		var ok bool
+		if id, ok = s.sessions.activeFor(req.GetPlayerId()); !ok {
+			return nil, status.Errorf(codes.NotFound, "player %q has no free spins in progress", req.GetPlayerId())
+		}
+	}
+	sess, err := s.loadSession(id)
+	if err != nil {
+		return nil, err
+	}
+	if sess.Feature == FeatureRespins {
+		return nil, status.Errorf(codes.NotFound, "session %s is not a free spins session", sess.ID)
+	}
+	return toFreeSpinsState(sess), nil
+}
+
+// sessionConfig returns the game configuration sess started on: the current
+// one while its version is unchanged, else the copy pinned when the session
+// started. A session whose configuration is gone can never finish, so it is
+// voided and its player can play on.
+func (s *engineServer) sessionConfig(sess *FeatureSession) (*GameConfig, error) {
+	cfg, err := s.games.get(sess.GameCode)
+	if err == nil && cfg.Version == sess.ConfigVersion {
+		return cfg, nil
+	}
+	pinned, perr := s.sessions.pinnedConfig(sess.GameCode, sess.ConfigVersion)
+	if perr == nil {
+		return pinned, nil
+	}
+	log.Printf("Session %s started on %s config %q, which is no longer available: %v", sess.ID, sess.GameCode, sess.ConfigVersion, perr)
+	if !sess.Completed {
+		sess.Completed = true
+		sess.Voided = true
+		if err := s.sessions.save(sess); err != nil {
+			log.Printf("failed to void session %s: %v", sess.ID, err)
+			return nil, status.Error(codes.Internal, "failed to save feature state")
+		}
+		log.Printf("Session %s voided, total win %d", sess.ID, sess.TotalWin)
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "game %s changed since the feature started; session %s is void", sess.GameCode, sess.ID)
+}
+
+// loadSession loads a feature session, mapping failures to gRPC statuses.
+func (s *engineServer) loadSession(id string) (*FeatureSession, error) {
+	sess, err := s.sessions.load(id)
+	if errors.Is(err, errNoSession) {
+		return nil, status.Errorf(codes.NotFound, "session %q not found", id)
+	}
+	if err != nil {
+		log.Printf("failed to load session %s: %v", id, err)
+		return nil, status.Error(codes.Internal, "failed to load feature state")
+	}
+	return sess, nil
+}
+
+func withoutFeature(features []FeatureTrigger, feature string) []FeatureTrigger {
+	var out []FeatureTrigger
+	for _, f := range features {
+		if f.Feature != feature {
+			out = append(out, f)
+		}
+	}
+	return out
+}
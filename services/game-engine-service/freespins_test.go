@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
+)
+
+const freeSpinsGameConfig = `{
+	"game_code": "fs_game", "version": %q,
+	"grid": {"rows": 1, "reels": 3},
+	"paylines": [[0, 0, 0]],
+	"paytable": {"A": {"3": %d}},
+	"free_spins": {"multiplier": 1},
+	"reel_strips": [["A"], ["A"], ["A"]]
+}`
+
+// newTestServer starts an engine on the configs in configDir and the state
+// in stateDir, as after a restart.
+func newTestServer(t *testing.T, configDir, stateDir string) *engineServer {
+	t.Helper()
+	sessions, err := openSessionStore(stateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &engineServer{rngClient: &fakeRNG{}, games: &gameConfigs{dir: configDir}, sessions: sessions}
+}
+
+func writeGameConfig(t *testing.T, dir, version string, pay int) {
+	t.Helper()
+	data := []byte(fmt.Sprintf(freeSpinsGameConfig, version, pay))
+	if err := os.WriteFile(filepath.Join(dir, "fs_game.json"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFreeSpinsAfterConfigChange(t *testing.T) {
+	configDir, stateDir := t.TempDir(), t.TempDir()
+	writeGameConfig(t, configDir, "1", 10)
+
+	s := newTestServer(t, configDir, stateDir)
+	cfg, err := s.games.get("fs_game")
+	if err != nil {
+		t.Fatal(err)
+	}
+	trigger := SpinResult{Features: []FeatureTrigger{{Feature: FeatureFreeSpins, Awarded: 3}}}
+	sess, err := s.startFreeSpins(cfg, "p1", "round", 1, trigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new version is deployed and the engine restarts: the session
+	// finishes on the maths it started on.
+	writeGameConfig(t, configDir, "2", 99)
+	s = newTestServer(t, configDir, stateDir)
+	resp, err := s.FreeSpin(context.Background(), &pb_engine.FreeSpinRequest{SessionId: sess.ID, SpinIndex: 0})
+	if err != nil {
+		t.Fatalf("free spin after config change: %v", err)
+	}
+	if resp.GetConfigVersion() != "1" || resp.GetTotalWin() != 10 {
+		t.Errorf("spin ran on version %q winning %d, want version 1 winning 10", resp.GetConfigVersion(), resp.GetTotalWin())
+	}
+
+	// Without the pinned copy the session cannot finish: it is voided and
+	// the player is free to play again.
+	if err := os.RemoveAll(filepath.Join(stateDir, "configs")); err != nil {
+		t.Fatal(err)
+	}
+	s = newTestServer(t, configDir, stateDir)
+	_, err = s.FreeSpin(context.Background(), &pb_engine.FreeSpinRequest{SessionId: sess.ID, SpinIndex: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("free spin without pinned config: %v, want FailedPrecondition", err)
+	}
+	state, err := s.GetFreeSpins(context.Background(), &pb_engine.GetFreeSpinsRequest{SessionId: sess.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.GetVoided() || !state.GetCompleted() || state.GetTotalWin() != 10 {
+		t.Errorf("voided session state %v, want voided, completed and total win 10", state)
+	}
+	if id, ok := s.sessions.activeFor("p1"); ok {
+		t.Errorf("player still has session %s in progress", id)
+	}
+	if _, err := s.Spin(context.Background(), &pb_engine.SpinRequest{GameCode: "fs_game", BetAmount: 1, PlayerId: "p1"}); err != nil {
+		t.Errorf("spin after void: %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gameConfigs loads game configurations from dir on first use, as
+// <game_code>.json, and caches them by game code.
+type gameConfigs struct {
+	dir string
+
+	mu    sync.Mutex
+	games map[string]*GameConfig
+}
+
+func (g *gameConfigs) get(gameCode string) (*GameConfig, error) {
+	if gameCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "game_code is required")
+	}
+	// Game codes name files; refuse anything that could leave dir.
+	if filepath.Base(gameCode) != gameCode || gameCode == ".." {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid game_code %q", gameCode)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if cfg, ok := g.games[gameCode]; ok {
+		return cfg, nil
+	}
+
+	path := filepath.Join(g.dir, gameCode+".json")
+	cfg, err := LoadGameConfig(path)
+	if err != nil {
+		log.Printf("failed to load game config %s: %v", path, err)
+		return nil, status.Errorf(codes.NotFound, "game %q is not available", gameCode)
+	}
+	if cfg.GameCode != gameCode {
+		log.Printf("game config %s declares game_code %q", path, cfg.GameCode)
+		return nil, status.Errorf(codes.NotFound, "game %q is not available", gameCode)
+	}
+	if g.games == nil {
+		g.games = make(map[string]*GameConfig)
+	}
+	g.games[gameCode] = cfg
+	log.Printf("Game %s loaded (config version %q)", gameCode, cfg.Version)
+	return cfg, nil
+}
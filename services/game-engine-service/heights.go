@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// validateHeights checks the variable reel height weights, if any.
+func (cfg *GameConfig) validateHeights() error {
+	if len(cfg.Grid.Heights) == 0 {
+		return nil
+	}
+	if cfg.Evaluation != EvalWays {
+		return errors.New("variable reel heights need ways evaluation")
+	}
+	tallest := 0
+	for h, w := range cfg.Grid.Heights {
+		if h < 1 || h > cfg.Grid.Rows {
+			return fmt.Errorf("reel height %d out of range 1..%d", h, cfg.Grid.Rows)
+		}
+		if w < 0 {
+			return fmt.Errorf("negative weight for reel height %d", h)
+		}
+		if w > 0 && h > tallest {
+			tallest = h
+		}
+	}
+	if cfg.heightWeightTotal() == 0 {
+		return errors.New("reel heights need a positive weight")
+	}
+	if tallest != cfg.Grid.Rows {
+		return fmt.Errorf("rows is %d but the tallest reel is %d", cfg.Grid.Rows, tallest)
+	}
+	return nil
+}
+
+// heightWeightTotal returns the sum of the reel height weights, 0 for a
+// fixed grid.
+func (cfg *GameConfig) heightWeightTotal() int {
+	total := 0
+	for _, w := range cfg.Grid.Heights {
+		total += w
+	}
+	return total
+}
+
+// reelHeights returns each reel's height for a spin. Fixed grids use Rows;
+// variable-height grids map the draws after the reel stops, each uniform in
+// [0, total weight), onto the heights in proportion to their weights.
+func (cfg *GameConfig) reelHeights(rngOutputs []int64) ([]int, error) {
+	reels := cfg.Grid.Reels
+	heights := make([]int, reels)
+	total := cfg.heightWeightTotal()
+	if total == 0 {
+		if len(rngOutputs) != reels {
+			return nil, fmt.Errorf("got %d RNG outputs for %d reels", len(rngOutputs), reels)
+		}
+		for i := range heights {
+			heights[i] = cfg.Grid.Rows
+		}
+		return heights, nil
+	}
+	if len(rngOutputs) != 2*reels {
+		return nil, fmt.Errorf("got %d RNG outputs for %d variable-height reels", len(rngOutputs), reels)
+	}
+
+	sizes := make([]int, 0, len(cfg.Grid.Heights))
+	for h := range cfg.Grid.Heights {
+		sizes = append(sizes, h)
+	}
+	sort.Ints(sizes)
+	for i, v := range rngOutputs[reels:] {
+		if v < 0 || v >= int64(total) {
+			return nil, fmt.Errorf("RNG height draw %d out of range for reel %d", v, i)
+		}
+		for _, h := range sizes {
+			if v < int64(cfg.Grid.Heights[h]) {
+				heights[i] = h
+				break
+			}
+			v -= int64(cfg.Grid.Heights[h])
+		}
+	}
+	return heights, nil
+}
+
+// ways returns the number of ways in play for the reel heights.
+func ways(heights []int) int {
+	n := 1
+	for _, h := range heights {
+		n *= h
+	}
+	return n
+}
+
+// reelHeight returns the number of cells showing on a reel of matrix; the
+// empty cells below a shorter reel do not count.
+func reelHeight(matrix [][]string, reel int) int {
+	height := 0
+	for height < len(matrix) && matrix[height][reel] != "" {
+		height++
+	}
+	return height
+}
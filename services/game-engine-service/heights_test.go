@@ -0,0 +1,87 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// heightsConfig draws each reel's height from 1 (weight 1), 2 (weight 1)
+// and 3 (weight 2), so height draws 0, 1 and 2-3 pick them.
+const heightsConfig = `{
+	"game_code": "heights", "version": "1", "evaluation": "ways", "bet_multiplier": 1,
+	"grid": {"rows": 3, "reels": 3, "heights": {"1": 1, "2": 1, "3": 2}},
+	"paytable": {"A": {"3": 1}},
+	"reel_strips": [["A"], ["A"], ["A"]]
+}`
+
+func TestReelHeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		outputs []int64
+		want    []int
+		wantErr bool
+	}{
+		{"each height", []int64{0, 0, 0, 0, 1, 3}, []int{1, 2, 3}, false},
+		{"weight boundaries", []int64{0, 0, 0, 1, 2, 0}, []int{2, 3, 1}, false},
+		{"all tallest", []int64{0, 0, 0, 2, 2, 2}, []int{3, 3, 3}, false},
+		{"missing height draws", []int64{0, 0, 0}, nil, true},
+		{"draw out of range", []int64{0, 0, 0, 0, 0, 4}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testConfig(t, heightsConfig).reelHeights(tt.outputs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %t", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("heights %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeightsSpin(t *testing.T) {
+	cfg := testConfig(t, heightsConfig)
+	if got, want := cfg.ReelBounds(), []int64{1, 1, 1, 4, 4, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reel bounds %v, want %v", got, want)
+	}
+
+	result, err := cfg.PerformSpin([]int64{0, 0, 0, 0, 1, 3}, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cells below a shorter reel stay empty and do not count as ways.
+	want := [][]string{{"A", "A", "A"}, {"", "A", "A"}, {"", "", "A"}}
+	if !reflect.DeepEqual(result.Matrix, want) {
+		t.Errorf("matrix %v, want %v", result.Matrix, want)
+	}
+	if ways(result.Heights) != 6 {
+		t.Errorf("%d ways in play, want 6", ways(result.Heights))
+	}
+	if len(result.WinLines) != 1 || result.WinLines[0].Ways != 6 || result.TotalWin != 6 {
+		t.Errorf("wins %+v totalling %d, want 6 ways paying 6", result.WinLines, result.TotalWin)
+	}
+}
+
+func TestValidateHeights(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*GameConfig)
+	}{
+		{"lines evaluation", func(cfg *GameConfig) { cfg.Evaluation = EvalLines }},
+		{"taller than the grid", func(cfg *GameConfig) { cfg.Grid.Heights[4] = 1 }},
+		{"zero height", func(cfg *GameConfig) { cfg.Grid.Heights[0] = 1 }},
+		{"negative weight", func(cfg *GameConfig) { cfg.Grid.Heights[2] = -1 }},
+		{"no weight", func(cfg *GameConfig) { cfg.Grid.Heights = map[int]int{1: 0, 3: 0} }},
+		{"tallest below rows", func(cfg *GameConfig) { cfg.Grid.Heights[3] = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, heightsConfig)
+			tt.modify(cfg)
+			if err := cfg.validateHeights(); err == nil {
+				t.Error("validate accepted the heights")
+			}
+		})
+	}
+}
@@ -4,45 +4,155 @@ import (
 	"context"
 	"log"
 	"net"
-	"time"
+	"os"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
 	// Import local engine proto
-	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto"
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
 	// Import remote RNG proto (Works now because it's a library package!)
-	pb_rng "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto"
+	pb_rng "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
 )
 
 type engineServer struct {
-	pb_engine.UnimplementedGameEngineServer
+	pb_engine.UnimplementedGameEngineServiceServer
 	rngClient pb_rng.RNGServiceClient
+	games     *gameConfigs
+	sessions  *sessionStore
 }
 
 func (s *engineServer) Spin(ctx context.Context, req *pb_engine.SpinRequest) (*pb_engine.SpinResponse, error) {
-	// Call RNG Service
-	rngResp, err := s.rngClient.GetNumbers(ctx, &pb_rng.RNGRequest{Count: 5})
+	cfg, err := s.games.get(req.GetGameCode())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cfg.BaseBet(int(req.GetBetAmount())); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bet_amount: %v", err)
+	}
+
+	// A player finishes their free spins or respins before staking another
+	// round.
+	if playerID := req.GetPlayerId(); playerID != "" {
+		unlock := s.sessions.lock("player:" + playerID)
+		defer unlock()
+		if id, ok := s.sessions.activeFor(playerID); ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "player %q has a feature in progress (session %s)", playerID, id)
+		}
+	}
+
+	roundID := req.GetRoundId()
+	if roundID == "" {
+		if roundID, err = newRoundID(); err != nil {
+			return nil, status.Error(codes.Internal, "failed to assign round id")
+		}
+	}
+
+	// Call RNG Service: one stop index per reel, bounded by its strip length
+	rngResp, err := s.rngClient.GetNumbers(ctx, &pb_rng.RNGRequest{
+		Bounds:  cfg.ReelBounds(),
+		Caller:  "game-engine-service",
+		RoundId: roundID,
+		Stream:  cfg.GameCode,
+	})
 	if err != nil {
 		log.Printf("Error calling RNG: %v", err)
 		return nil, err
 	}
 
-	// Logic: Use the random numbers
-	// FIX: Use 'Numbers' field (Capitalized)
-	log.Printf("Got RNG numbers: %v", rngResp.Numbers)
+	result, err := cfg.PerformSpin(rngResp.GetNumbers(), int(req.GetBetAmount()), s.rngFill(ctx, cfg, roundID), s.rngDraw(ctx, cfg, roundID))
+	if err != nil {
+		log.Printf("Round %s failed to resolve: %v", roundID, err)
+		return nil, status.Error(codes.Internal, "failed to resolve spin")
+	}
+
+	sess, err := s.startFreeSpins(cfg, req.GetPlayerId(), roundID, int(req.GetBetAmount()), result)
+	if err != nil {
+		log.Printf("Round %s: failed to start free spins: %v", roundID, err)
+		return nil, status.Error(codes.Internal, "failed to save free spins state")
+	}
+	if sess != nil {
+		// A player plays one feature at a time; free spins take precedence.
+		result.Features = withoutFeature(result.Features, FeatureRespins)
+		resp := toSpinResponse(cfg, roundID, rngResp.GetAuditId(), result)
+		resp.FreeSpins = toFreeSpinsState(sess)
+		return resp, nil
+	}
 
-	// Dummy response
-	return &pb_engine.SpinResponse{
-		Matrix: []string{"A", "B", "C"},
-		TotalWin: 100,
-	}, nil
+	resp := toSpinResponse(cfg, roundID, rngResp.GetAuditId(), result)
+	bonus, err := s.startRespins(ctx, cfg, req.GetPlayerId(), roundID, int(req.GetBetAmount()), result)
+	if err != nil {
+		log.Printf("Round %s: failed to start respins: %v", roundID, err)
+		return nil, status.Error(codes.Internal, "failed to start respins")
+	}
+	if bonus != nil {
+		resp.Respins = toRespinsState(cfg, bonus)
+	}
+	return resp, nil
+}
+
+// rngFill returns a FillFunc that draws weighted picks for one round, i.e.
+// cascade fills and respins coins, from the RNG service on the game's stream.
+func (s *engineServer) rngFill(ctx context.Context, cfg *GameConfig, roundID string) FillFunc {
+	return func(tables [][]int64) ([]int64, string, error) {
+		req := &pb_rng.WeightedRequest{
+			Caller:  "game-engine-service",
+			RoundId: roundID,
+			Stream:  cfg.GameCode,
+		}
+		for _, weights := range tables {
+			req.Tables = append(req.Tables, &pb_rng.WeightTable{Weights: weights})
+		}
+		resp, err := s.rngClient.GetWeighted(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.GetIndices(), resp.GetAuditId(), nil
+	}
+}
+
+// rngDraw returns a DrawFunc that draws bounded numbers for one round, i.e.
+// walking wild respin stops, from the RNG service on the game's stream.
+func (s *engineServer) rngDraw(ctx context.Context, cfg *GameConfig, roundID string) DrawFunc {
+	return func(bounds []int64) ([]int64, string, error) {
+		resp, err := s.rngClient.GetNumbers(ctx, &pb_rng.RNGRequest{
+			Bounds:  bounds,
+			Caller:  "game-engine-service",
+			RoundId: roundID,
+			Stream:  cfg.GameCode,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.GetNumbers(), resp.GetAuditId(), nil
+	}
 }
 
 func main() {
+	// Game configurations are read from GAME_CONFIG_DIR (default "config").
+	configDir := os.Getenv("GAME_CONFIG_DIR")
+	if configDir == "" {
+		configDir = "config"
+	}
+	// Free spins and respins state is kept in ENGINE_STATE_DIR (default "state").
+	stateDir := os.Getenv("ENGINE_STATE_DIR")
+	if stateDir == "" {
+		stateDir = "state"
+	}
+	sessions, err := openSessionStore(stateDir)
+	if err != nil {
+		log.Fatalf("failed to open session store: %v", err)
+	}
+	rngAddr := os.Getenv("RNG_SERVER_ADDR")
+	if rngAddr == "" {
+		rngAddr = "rng-service:50051"
+	}
+
 	// Connect to RNG Service
-	conn, err := grpc.Dial("rng-service:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(rngAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatalf("did not connect to RNG: %v", err)
 	}
@@ -56,11 +166,11 @@ func main() {
 	}
 
 	s := grpc.NewServer()
-	pb_engine.RegisterGameEngineServer(s, &engineServer{rngClient: rngClient})
+	pb_engine.RegisterGameEngineServiceServer(s, &engineServer{rngClient: rngClient, games: &gameConfigs{dir: configDir}, sessions: sessions})
 	reflection.Register(s)
 
 	log.Printf("Game Engine listening on :50052")
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
-}
\ No newline at end of file
+}
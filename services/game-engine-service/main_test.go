@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
+	pb_rng "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// testConfig decodes and validates a game configuration for tests.
+func testConfig(t *testing.T, raw string) *GameConfig {
+	t.Helper()
+	cfg := &GameConfig{}
+	if err := json.Unmarshal([]byte(raw), cfg); err != nil {
+		t.Fatalf("decode config: %v", err)
+	}
+	if err := cfg.validate(); err != nil {
+		t.Fatalf("validate config: %v", err)
+	}
+	return cfg
+}
+
+// drawSequence returns a FillFunc answering each call with the next of
+// draws, for tests.
+func drawSequence(t *testing.T, draws ...[]int64) FillFunc {
+	return func(tables [][]int64) ([]int64, string, error) {
+		if len(draws) == 0 {
+			t.Fatalf("unexpected draw for %d tables", len(tables))
+		}
+		next := draws[0]
+		draws = draws[1:]
+		return next, "audit", nil
+	}
+}
+
+// fakeRNG answers every draw with zeros and records the GetNumbers
+// requests it receives.
+type fakeRNG struct {
+	pb_rng.RNGServiceClient
+	numbers []*pb_rng.RNGRequest
+}
+
+func (f *fakeRNG) GetNumbers(ctx context.Context, req *pb_rng.RNGRequest, opts ...grpc.CallOption) (*pb_rng.RNGResponse, error) {
+	f.numbers = append(f.numbers, req)
+	return &pb_rng.RNGResponse{Numbers: make([]int64, len(req.GetBounds())), AuditId: "audit"}, nil
+}
+
+func (f *fakeRNG) GetWeighted(ctx context.Context, req *pb_rng.WeightedRequest, opts ...grpc.CallOption) (*pb_rng.WeightedResponse, error) {
+	return &pb_rng.WeightedResponse{Indices: make([]int64, len(req.GetTables())), AuditId: "audit"}, nil
+}
+
+const spinGameConfig = `{
+	"game_code": "spin_game", "version": "1",
+	"grid": {"rows": 1, "reels": 3},
+	"paylines": [[0, 0, 0]],
+	"paytable": {"A": {"3": 5}},
+	"reel_strips": [["A", "B"], ["A", "B", "C"], ["A"]]
+}`
+
+func TestSpinRNGRequest(t *testing.T) {
+	configDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(configDir, "spin_game.json"), []byte(spinGameConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s := newTestServer(t, configDir, t.TempDir())
+	rng := s.rngClient.(*fakeRNG)
+	ctx := context.Background()
+
+	resp, err := s.Spin(ctx, &pb_engine.SpinRequest{GameCode: "spin_game", BetAmount: 1, RoundId: "round-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rng.numbers) != 1 {
+		t.Fatalf("%d RNG requests, want 1", len(rng.numbers))
+	}
+	req := rng.numbers[0]
+	if want := []int64{2, 3, 1}; !reflect.DeepEqual(req.GetBounds(), want) {
+		t.Errorf("bounds %v, want the strip lengths %v", req.GetBounds(), want)
+	}
+	if req.GetCaller() != "game-engine-service" || req.GetRoundId() != "round-1" || req.GetStream() != "spin_game" {
+		t.Errorf("caller %q, round %q, stream %q; want game-engine-service, round-1 and spin_game", req.GetCaller(), req.GetRoundId(), req.GetStream())
+	}
+	if resp.GetRoundId() != "round-1" || resp.GetRngAuditId() != "audit" || resp.GetTotalWin() != 5 {
+		t.Errorf("response round %q, audit id %q, win %d", resp.GetRoundId(), resp.GetRngAuditId(), resp.GetTotalWin())
+	}
+
+	// A round without an id gets one, and the draw is made under it.
+	resp, err = s.Spin(ctx, &pb_engine.SpinRequest{GameCode: "spin_game", BetAmount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := rng.numbers[1].GetRoundId(); id == "" || id != resp.GetRoundId() {
+		t.Errorf("draw under round %q for round %q", id, resp.GetRoundId())
+	}
+}
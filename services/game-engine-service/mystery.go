@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MysteryConfig describes mystery symbols: after the reels stop, every
+// mystery on the grid reveals the same symbol, drawn from weights.
+type MysteryConfig struct {
+	// Symbol is the mystery symbol as it appears on the reel strips.
+	Symbol string `json:"symbol"`
+	// Weights of the revealed symbol in the base game, e.g.
+	// {"S_HIGH_A": 1, "S_LOW_D": 10, "S_WILD": 2}.
+	Weights map[string]int `json:"weights"`
+	// FreeSpinsWeights are used in free spins; Weights when empty.
+	FreeSpinsWeights map[string]int `json:"free_spins_weights"`
+}
+
+// MysteryReveal records mystery symbols revealed on a grid.
+type MysteryReveal struct {
+	Symbol    string     `json:"symbol"` // the symbol every mystery became
+	Positions []Position `json:"positions"`
+	AuditID   string     `json:"audit_id"` // the RNG draw behind Symbol
+}
+
+func (cfg *GameConfig) validateMystery() error {
+	m := cfg.Mystery
+	if m.Symbol == "" {
+		return errors.New("symbol is required")
+	}
+	if cfg.Cascade != nil {
+		return errors.New("mystery symbols cannot be combined with cascades")
+	}
+	if _, ok := cfg.Paytable[m.Symbol]; ok || cfg.isWild(m.Symbol) {
+		return fmt.Errorf("%s is also a paying or wild symbol", m.Symbol)
+	}
+	if err := m.validateWeights(m.Weights); err != nil {
+		return err
+	}
+	if len(m.FreeSpinsWeights) > 0 {
+		if err := m.validateWeights(m.FreeSpinsWeights); err != nil {
+			return fmt.Errorf("free spins: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *MysteryConfig) validateWeights(weights map[string]int) error {
+	total := 0
+	for symbol, w := range weights {
+		if symbol == "" || symbol == m.Symbol || w < 0 {
+			return fmt.Errorf("invalid weight %d for %q", w, symbol)
+		}
+		total += w
+	}
+	if total == 0 {
+		return errors.New("weights need a positive total")
+	}
+	return nil
+}
+
+// mysteryWeights returns the reveal weights for base or free spins, nil for
+// games without mystery symbols.
+func (cfg *GameConfig) mysteryWeights(freeSpins bool) map[string]int {
+	m := cfg.Mystery
+	if m == nil {
+		return nil
+	}
+	if freeSpins && len(m.FreeSpinsWeights) > 0 {
+		return m.FreeSpinsWeights
+	}
+	return m.Weights
+}
+
+// reveal turns every mystery symbol on matrix into one symbol drawn from
+// weights through fill. It returns nil when no mystery landed.
+func (cfg *GameConfig) reveal(matrix [][]string, weights map[string]int, fill FillFunc) (*MysteryReveal, error) {
+	if cfg.Mystery == nil {
+		return nil, nil
+	}
+	positions := symbolPositions(matrix, cfg.Mystery.Symbol)
+	if len(positions) == 0 {
+		return nil, nil
+	}
+	if fill == nil {
+		return nil, errors.New("mystery: reveal without an RNG")
+	}
+
+	symbols, table := weightTable(weights)
+	indices, auditID, err := fill([][]int64{table})
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) != 1 || indices[0] < 0 || int(indices[0]) >= len(symbols) {
+		return nil, fmt.Errorf("mystery: invalid reveal draw %v", indices)
+	}
+
+	symbol := symbols[indices[0]]
+	for _, p := range positions {
+		matrix[p.Row][p.Reel] = symbol
+	}
+	return &MysteryReveal{Symbol: symbol, Positions: positions, AuditID: auditID}, nil
+}
+
+// copyMatrix returns a copy of matrix that later transforms do not touch.
+func copyMatrix(matrix [][]string) [][]string {
+	out := make([][]string, len(matrix))
+	for r, row := range matrix {
+		out[r] = append([]string(nil), row...)
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// mysteryConfig reveals M as A or B in the base game (draw 0 or 1) and
+// always as A in free spins.
+const mysteryConfig = `{
+	"game_code": "mystery", "version": "1",
+	"grid": {"rows": 1, "reels": 3},
+	"paylines": [[0, 0, 0]],
+	"paytable": {"A": {"3": 10}, "B": {"3": 5}},
+	"mystery": {"symbol": "M", "weights": {"A": 1, "B": 1}, "free_spins_weights": {"A": 1}},
+	"free_spins": {"multiplier": 1},
+	"reel_strips": [["M", "A"], ["M", "B"], ["M", "A"]]
+}`
+
+func TestMystery(t *testing.T) {
+	tests := []struct {
+		name    string
+		free    bool
+		stops   []int64
+		draws   [][]int64
+		symbol  string // revealed, "" for none
+		matrix  []string
+		win     int
+		reveals int
+	}{
+		{"all mysteries", false, []int64{0, 0, 0}, [][]int64{{1}}, "B", []string{"B", "B", "B"}, 5, 3},
+		{"completes a line", false, []int64{0, 1, 0}, [][]int64{{1}}, "B", []string{"B", "B", "B"}, 5, 2},
+		{"reveal misses", false, []int64{0, 1, 0}, [][]int64{{0}}, "A", []string{"A", "B", "A"}, 0, 2},
+		{"no mystery", false, []int64{1, 1, 1}, nil, "", []string{"A", "B", "A"}, 0, 0},
+		{"free spins weights", true, []int64{0, 0, 0}, [][]int64{{0}}, "A", []string{"A", "A", "A"}, 10, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, mysteryConfig)
+			fill := drawSequence(t, tt.draws...)
+			var result SpinResult
+			var err error
+			if tt.free {
+				result, err = cfg.PerformFreeSpin(tt.stops, 1, nil, fill, nil)
+			} else {
+				result, err = cfg.PerformSpin(tt.stops, 1, fill, nil)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(result.Matrix[0], tt.matrix) || result.TotalWin != tt.win {
+				t.Errorf("got %v winning %d, want %v winning %d", result.Matrix[0], result.TotalWin, tt.matrix, tt.win)
+			}
+			if tt.symbol == "" {
+				if result.Mystery != nil || result.PreRevealMatrix != nil {
+					t.Errorf("reveal %+v without a mystery", result.Mystery)
+				}
+				return
+			}
+			if result.Mystery == nil || result.Mystery.Symbol != tt.symbol || len(result.Mystery.Positions) != tt.reveals {
+				t.Fatalf("reveal %+v, want %d of %s", result.Mystery, tt.reveals, tt.symbol)
+			}
+			for _, p := range result.Mystery.Positions {
+				if result.PreRevealMatrix[p.Row][p.Reel] != "M" {
+					t.Errorf("pre-reveal grid shows %s at %+v, want M", result.PreRevealMatrix[p.Row][p.Reel], p)
+				}
+			}
+		})
+	}
+}
+
+func TestMysteryWithoutRNG(t *testing.T) {
+	cfg := testConfig(t, mysteryConfig)
+	if _, err := cfg.PerformSpin([]int64{0, 0, 0}, 1, nil, nil); err == nil {
+		t.Error("mystery reveal without an RNG succeeded")
+	}
+}
+
+func TestValidateMystery(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*GameConfig)
+	}{
+		{"no symbol", func(cfg *GameConfig) { cfg.Mystery.Symbol = "" }},
+		{"paying symbol", func(cfg *GameConfig) { cfg.Mystery.Symbol = "A" }},
+		{"with cascades", func(cfg *GameConfig) { cfg.Cascade = &CascadeConfig{} }},
+		{"reveals itself", func(cfg *GameConfig) { cfg.Mystery.Weights["M"] = 1 }},
+		{"no weight", func(cfg *GameConfig) { cfg.Mystery.Weights = map[string]int{"A": 0} }},
+		{"bad free spins weights", func(cfg *GameConfig) { cfg.Mystery.FreeSpinsWeights = map[string]int{"A": -1} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, mysteryConfig)
+			tt.modify(cfg)
+			if err := cfg.validateMystery(); err == nil {
+				t.Error("validate accepted the mystery")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+// evaluateLines pays every payline on matrix (rows x reels), left to right.
+// Each line pays its single best win: either the first non-wild symbol with
+// wilds substituting for it, or the leading run of wilds on its own.
+func (cfg *GameConfig) evaluateLines(matrix [][]string, lineBet int) []WinLine {
+	var wins []WinLine
+	for i, line := range cfg.Paylines {
+		symbols := make([]string, len(line))
+		for reel, row := range line {
+			symbols[reel] = matrix[row][reel]
+		}
+		if win, ok := cfg.bestLineWin(symbols); ok {
+			win.LineID = i + 1
+			for reel := 0; reel < win.Count; reel++ {
+				win.Positions = append(win.Positions, Position{Reel: reel, Row: line[reel]})
+			}
+			win.Multiplier = 1
+			win.Payout *= lineBet
+			wins = append(wins, win)
+		}
+	}
+	return wins
+}
+
+// bestLineWin returns the highest paying win for the symbols on one line,
+// with Payout holding the paytable pay in line bets.
+func (cfg *GameConfig) bestLineWin(symbols []string) (WinLine, bool) {
+	// Wild-only run, paid as the wild symbol itself.
+	wilds := 0
+	for wilds < len(symbols) && cfg.isWild(symbols[wilds]) {
+		wilds++
+	}
+	var best WinLine
+	if wilds > 0 {
+		if pay := cfg.Paytable[symbols[0]][wilds]; pay > 0 {
+			best = WinLine{Symbol: symbols[0], Count: wilds, Payout: pay}
+		}
+	}
+	if wilds == len(symbols) {
+		return best, best.Payout > 0
+	}
+
+	// The first non-wild symbol with wilds substituting for it. Symbols
+	// without pays (e.g. scatters) are never substituted.
+	target := symbols[wilds]
+	if _, ok := cfg.Paytable[target]; ok {
+		count := wilds
+		for count < len(symbols) && (symbols[count] == target || cfg.isWild(symbols[count])) {
+			count++
+		}
+		if pay := cfg.Paytable[target][count]; pay > best.Payout {
+			best = WinLine{Symbol: target, Count: count, Payout: pay}
+		}
+	}
+	return best, best.Payout > 0
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+const linesConfig = `{
+	"game_code": "lines", "version": "1",
+	"grid": {"rows": 1, "reels": 5},
+	"paylines": [[0, 0, 0, 0, 0]],
+	"paytable": {
+		"A": {"3": 5, "4": 10, "5": 20},
+		"B": {"3": 1, "4": 2, "5": 3},
+		"W": {"3": 50}
+	},
+	"wilds": ["W"],
+	"scatters": {"S": {"3": 1}},
+	"reel_strips": [["A"], ["A"], ["A"], ["A"], ["A"]]
+}`
+
+func TestEvaluateLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   []string
+		symbol string
+		count  int
+		pay    int // in line bets
+	}{
+		{"five of a kind", []string{"A", "A", "A", "A", "A"}, "A", 5, 20},
+		{"three from the left", []string{"A", "A", "A", "B", "A"}, "A", 3, 5},
+		{"not from the left", []string{"B", "A", "A", "A", "A"}, "", 0, 0},
+		{"too short", []string{"A", "A", "B", "B", "B"}, "", 0, 0},
+		{"wild substitutes", []string{"W", "A", "A", "A", "B"}, "A", 4, 10},
+		{"leading wilds take the symbol", []string{"W", "W", "A", "A", "A"}, "A", 5, 20},
+		{"wild run pays more", []string{"W", "W", "W", "B", "C"}, "W", 3, 50},
+		{"all wilds without a pay for five", []string{"W", "W", "W", "W", "W"}, "", 0, 0},
+		{"wild does not substitute a scatter", []string{"W", "W", "S", "A", "A"}, "", 0, 0},
+		{"broken by an unpaid symbol", []string{"A", "C", "A", "A", "A"}, "", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, linesConfig)
+			wins := cfg.evaluateLines([][]string{tt.line}, 2)
+			if tt.pay == 0 {
+				if len(wins) != 0 {
+					t.Fatalf("got wins %+v, want none", wins)
+				}
+				return
+			}
+			if len(wins) != 1 {
+				t.Fatalf("got %d wins, want 1", len(wins))
+			}
+			w := wins[0]
+			if w.Symbol != tt.symbol || w.Count != tt.count || w.Payout != 2*tt.pay {
+				t.Errorf("got %s x%d paying %d, want %s x%d paying %d", w.Symbol, w.Count, w.Payout, tt.symbol, tt.count, 2*tt.pay)
+			}
+		})
+	}
+}
+
+func TestPaylinePositions(t *testing.T) {
+	const raw = `{
+		"game_code": "lines3", "version": "1",
+		"grid": {"rows": 3, "reels": 3},
+		"paylines": [[0, 0, 0], [1, 1, 1], [0, 1, 2]],
+		"paytable": {"A": {"3": 4}},
+		"reel_strips": [["A"], ["A"], ["A"]]
+	}`
+	cfg := testConfig(t, raw)
+	matrix := [][]string{
+		{"A", "A", "B"},
+		{"B", "A", "B"},
+		{"B", "B", "A"},
+	}
+	wins := cfg.evaluateLines(matrix, 1)
+	if len(wins) != 1 {
+		t.Fatalf("got %d wins, want 1: %+v", len(wins), wins)
+	}
+	want := WinLine{
+		LineID:     3,
+		Symbol:     "A",
+		Count:      3,
+		Positions:  []Position{{Reel: 0, Row: 0}, {Reel: 1, Row: 1}, {Reel: 2, Row: 2}},
+		Multiplier: 1,
+		Payout:     4,
+	}
+	if !reflect.DeepEqual(wins[0], want) {
+		t.Errorf("got %+v, want %+v", wins[0], want)
+	}
+}
+
+func TestBaseBet(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		bet     int
+		want    int
+		wantErr bool
+	}{
+		{"line bet", linesConfig, 10, 10, false},
+		{"ways unit", waysConfig, 50, 25, false},
+		{"not a multiple", waysConfig, 3, 0, true},
+		{"zero bet", linesConfig, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testConfig(t, tt.raw).BaseBet(tt.bet)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("BaseBet(%d) = %d, %v; want %d, error %t", tt.bet, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,1905 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: engine/v1/engine.proto
+
+package enginev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpinRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GameCode string                 `protobuf:"bytes,1,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"`
+	// Total stake for the round, in minor currency units.
+	BetAmount int64 `protobuf:"varint,2,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount,omitempty"`
+	// Caller's id for the round, e.g. the gateway's wallet transaction.
+	// The engine assigns one when empty.
+	RoundId string `protobuf:"bytes,3,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Player the round belongs to. When set, the engine refuses base spins
+	// while the player has a free spins feature in progress.
+	PlayerId      string `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpinRequest) Reset() {
+	*x = SpinRequest{}
+	mi := &file_engine_v1_engine_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpinRequest) ProtoMessage() {}
+
+func (x *SpinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpinRequest.ProtoReflect.Descriptor instead.
+func (*SpinRequest) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpinRequest) GetGameCode() string {
+	if x != nil {
+		return x.GameCode
+	}
+	return ""
+}
+
+func (x *SpinRequest) GetBetAmount() int64 {
+	if x != nil {
+		return x.BetAmount
+	}
+	return 0
+}
+
+func (x *SpinRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *SpinRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type FreeSpinRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Index of the spin to play, i.e. spins_played from the latest state.
+	// Repeating the previous index returns that spin's result again instead
+	// of playing another, so a lost response can be retried safely.
+	SpinIndex     int32 `protobuf:"varint,2,opt,name=spin_index,json=spinIndex,proto3" json:"spin_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeSpinRequest) Reset() {
+	*x = FreeSpinRequest{}
+	mi := &file_engine_v1_engine_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeSpinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeSpinRequest) ProtoMessage() {}
+
+func (x *FreeSpinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeSpinRequest.ProtoReflect.Descriptor instead.
+func (*FreeSpinRequest) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FreeSpinRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FreeSpinRequest) GetSpinIndex() int32 {
+	if x != nil {
+		return x.SpinIndex
+	}
+	return 0
+}
+
+type GetFreeSpinsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feature to return. When empty, the player's feature in progress.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFreeSpinsRequest) Reset() {
+	*x = GetFreeSpinsRequest{}
+	mi := &file_engine_v1_engine_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFreeSpinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreeSpinsRequest) ProtoMessage() {}
+
+func (x *GetFreeSpinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreeSpinsRequest.ProtoReflect.Descriptor instead.
+func (*GetFreeSpinsRequest) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFreeSpinsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetFreeSpinsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type FreeSpinsState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameCode  string                 `protobuf:"bytes,2,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Round that triggered the feature.
+	RoundId string `protobuf:"bytes,4,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Bet of the triggering round, used to evaluate every free spin.
+	BetAmount int64 `protobuf:"varint,5,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount,omitempty"`
+	// Spins awarded so far, including retriggers.
+	SpinsAwarded int32 `protobuf:"varint,6,opt,name=spins_awarded,json=spinsAwarded,proto3" json:"spins_awarded,omitempty"`
+	SpinsPlayed  int32 `protobuf:"varint,7,opt,name=spins_played,json=spinsPlayed,proto3" json:"spins_played,omitempty"`
+	// Multiplier applied to every free spin win.
+	Multiplier int64 `protobuf:"varint,8,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Sum of the free spin wins so far, in the same units as bet_amount.
+	TotalWin int64 `protobuf:"varint,9,opt,name=total_win,json=totalWin,proto3" json:"total_win,omitempty"`
+	// Set once every awarded spin has been played; total_win is then final.
+	Completed bool `protobuf:"varint,10,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Set when the feature was closed unfinished because the game
+	// configuration it started on is no longer available; completed is then
+	// set and total_win holds the wins up to then.
+	Voided bool `protobuf:"varint,11,opt,name=voided,proto3" json:"voided,omitempty"`
+	// Sticky wilds held in place for the remaining free spins.
+	StickyWilds   []*WildCell `protobuf:"bytes,12,rep,name=sticky_wilds,json=stickyWilds,proto3" json:"sticky_wilds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeSpinsState) Reset() {
+	*x = FreeSpinsState{}
+	mi := &file_engine_v1_engine_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeSpinsState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeSpinsState) ProtoMessage() {}
+
+func (x *FreeSpinsState) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeSpinsState.ProtoReflect.Descriptor instead.
+func (*FreeSpinsState) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FreeSpinsState) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FreeSpinsState) GetGameCode() string {
+	if x != nil {
+		return x.GameCode
+	}
+	return ""
+}
+
+func (x *FreeSpinsState) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *FreeSpinsState) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *FreeSpinsState) GetBetAmount() int64 {
+	if x != nil {
+		return x.BetAmount
+	}
+	return 0
+}
+
+func (x *FreeSpinsState) GetSpinsAwarded() int32 {
+	if x != nil {
+		return x.SpinsAwarded
+	}
+	return 0
+}
+
+func (x *FreeSpinsState) GetSpinsPlayed() int32 {
+	if x != nil {
+		return x.SpinsPlayed
+	}
+	return 0
+}
+
+func (x *FreeSpinsState) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *FreeSpinsState) GetTotalWin() int64 {
+	if x != nil {
+		return x.TotalWin
+	}
+	return 0
+}
+
+func (x *FreeSpinsState) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *FreeSpinsState) GetVoided() bool {
+	if x != nil {
+		return x.Voided
+	}
+	return false
+}
+
+func (x *FreeSpinsState) GetStickyWilds() []*WildCell {
+	if x != nil {
+		return x.StickyWilds
+	}
+	return nil
+}
+
+type RespinRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Index of the respin to play, i.e. respins_played from the latest state.
+	// Repeating the previous index returns that respin again.
+	RespinIndex   int32 `protobuf:"varint,2,opt,name=respin_index,json=respinIndex,proto3" json:"respin_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespinRequest) Reset() {
+	*x = RespinRequest{}
+	mi := &file_engine_v1_engine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespinRequest) ProtoMessage() {}
+
+func (x *RespinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespinRequest.ProtoReflect.Descriptor instead.
+func (*RespinRequest) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RespinRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RespinRequest) GetRespinIndex() int32 {
+	if x != nil {
+		return x.RespinIndex
+	}
+	return 0
+}
+
+type GetRespinsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bonus to return. When empty, the player's feature in progress.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRespinsRequest) Reset() {
+	*x = GetRespinsRequest{}
+	mi := &file_engine_v1_engine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRespinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRespinsRequest) ProtoMessage() {}
+
+func (x *GetRespinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRespinsRequest.ProtoReflect.Descriptor instead.
+func (*GetRespinsRequest) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRespinsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRespinsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// A coin locked on the respins grid.
+type Coin struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Position *Position              `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// Cash value, in the same units as bet_amount.
+	Value         int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coin) Reset() {
+	*x = Coin{}
+	mi := &file_engine_v1_engine_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coin) ProtoMessage() {}
+
+func (x *Coin) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coin.ProtoReflect.Descriptor instead.
+func (*Coin) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Coin) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Coin) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type RespinsState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameCode  string                 `protobuf:"bytes,2,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Round that triggered the bonus.
+	RoundId   string `protobuf:"bytes,4,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	BetAmount int64  `protobuf:"varint,5,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount,omitempty"`
+	// Respins remaining; reset whenever a respin lands a coin.
+	RespinsLeft   int32 `protobuf:"varint,6,opt,name=respins_left,json=respinsLeft,proto3" json:"respins_left,omitempty"`
+	RespinsPlayed int32 `protobuf:"varint,7,opt,name=respins_played,json=respinsPlayed,proto3" json:"respins_played,omitempty"`
+	// Size of the respins grid.
+	Rows  int32 `protobuf:"varint,8,opt,name=rows,proto3" json:"rows,omitempty"`
+	Reels int32 `protobuf:"varint,9,opt,name=reels,proto3" json:"reels,omitempty"`
+	// Every locked coin, in reel order.
+	Coins []*Coin `protobuf:"bytes,10,rep,name=coins,proto3" json:"coins,omitempty"`
+	// Set when every cell holds a coin; total_win then includes the grand prize.
+	Grand bool `protobuf:"varint,11,opt,name=grand,proto3" json:"grand,omitempty"`
+	// Sum of the coins so far, in the same units as bet_amount.
+	TotalWin int64 `protobuf:"varint,12,opt,name=total_win,json=totalWin,proto3" json:"total_win,omitempty"`
+	// Set once the respins run out or the grid fills; total_win is then final.
+	Completed bool `protobuf:"varint,13,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Audit id of the RNG draw behind the latest coins.
+	RngAuditId string `protobuf:"bytes,14,opt,name=rng_audit_id,json=rngAuditId,proto3" json:"rng_audit_id,omitempty"`
+	// Set when the bonus was closed unfinished because the game configuration
+	// it started on is no longer available, as for FreeSpinsState.
+	Voided        bool `protobuf:"varint,15,opt,name=voided,proto3" json:"voided,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespinsState) Reset() {
+	*x = RespinsState{}
+	mi := &file_engine_v1_engine_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespinsState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespinsState) ProtoMessage() {}
+
+func (x *RespinsState) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespinsState.ProtoReflect.Descriptor instead.
+func (*RespinsState) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RespinsState) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RespinsState) GetGameCode() string {
+	if x != nil {
+		return x.GameCode
+	}
+	return ""
+}
+
+func (x *RespinsState) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RespinsState) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *RespinsState) GetBetAmount() int64 {
+	if x != nil {
+		return x.BetAmount
+	}
+	return 0
+}
+
+func (x *RespinsState) GetRespinsLeft() int32 {
+	if x != nil {
+		return x.RespinsLeft
+	}
+	return 0
+}
+
+func (x *RespinsState) GetRespinsPlayed() int32 {
+	if x != nil {
+		return x.RespinsPlayed
+	}
+	return 0
+}
+
+func (x *RespinsState) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *RespinsState) GetReels() int32 {
+	if x != nil {
+		return x.Reels
+	}
+	return 0
+}
+
+func (x *RespinsState) GetCoins() []*Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *RespinsState) GetGrand() bool {
+	if x != nil {
+		return x.Grand
+	}
+	return false
+}
+
+func (x *RespinsState) GetTotalWin() int64 {
+	if x != nil {
+		return x.TotalWin
+	}
+	return 0
+}
+
+func (x *RespinsState) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *RespinsState) GetRngAuditId() string {
+	if x != nil {
+		return x.RngAuditId
+	}
+	return ""
+}
+
+func (x *RespinsState) GetVoided() bool {
+	if x != nil {
+		return x.Voided
+	}
+	return false
+}
+
+type RespinResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoundId string                 `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Coins this respin landed, in reel order; empty for a blank respin.
+	Landed []*Coin `protobuf:"bytes,2,rep,name=landed,proto3" json:"landed,omitempty"`
+	// The bonus after the respin.
+	Respins       *RespinsState `protobuf:"bytes,3,opt,name=respins,proto3" json:"respins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespinResponse) Reset() {
+	*x = RespinResponse{}
+	mi := &file_engine_v1_engine_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespinResponse) ProtoMessage() {}
+
+func (x *RespinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespinResponse.ProtoReflect.Descriptor instead.
+func (*RespinResponse) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RespinResponse) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *RespinResponse) GetLanded() []*Coin {
+	if x != nil {
+		return x.Landed
+	}
+	return nil
+}
+
+func (x *RespinResponse) GetRespins() *RespinsState {
+	if x != nil {
+		return x.Respins
+	}
+	return nil
+}
+
+// A wild held on the grid, e.g. a sticky or walking wild.
+type WildCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *Position              `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WildCell) Reset() {
+	*x = WildCell{}
+	mi := &file_engine_v1_engine_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WildCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WildCell) ProtoMessage() {}
+
+func (x *WildCell) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WildCell.ProtoReflect.Descriptor instead.
+func (*WildCell) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WildCell) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *WildCell) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// A respin awarded by walking wilds: each moved one reel to the left and
+// every other cell spun again.
+type WildRespin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Grid  *Grid                  `protobuf:"bytes,1,opt,name=grid,proto3" json:"grid,omitempty"`
+	// Stop index drawn for each reel, in reel order.
+	Stops []int64    `protobuf:"varint,2,rep,packed,name=stops,proto3" json:"stops,omitempty"`
+	Wins  []*WinLine `protobuf:"bytes,3,rep,name=wins,proto3" json:"wins,omitempty"`
+	Win   int64      `protobuf:"varint,4,opt,name=win,proto3" json:"win,omitempty"`
+	// Walking wilds moved onto this grid.
+	Walking []*WildCell `protobuf:"bytes,5,rep,name=walking,proto3" json:"walking,omitempty"`
+	// Reels filled by expanding wilds; grid shows them expanded.
+	ExpandedReels []int32 `protobuf:"varint,6,rep,packed,name=expanded_reels,json=expandedReels,proto3" json:"expanded_reels,omitempty"`
+	// Audit id of the RNG draw behind the stops.
+	RngAuditId string `protobuf:"bytes,7,opt,name=rng_audit_id,json=rngAuditId,proto3" json:"rng_audit_id,omitempty"`
+	// Mystery symbols revealed on this grid, as for SpinResponse.
+	PreRevealGrid *Grid          `protobuf:"bytes,8,opt,name=pre_reveal_grid,json=preRevealGrid,proto3" json:"pre_reveal_grid,omitempty"`
+	Mystery       *MysteryReveal `protobuf:"bytes,9,opt,name=mystery,proto3" json:"mystery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WildRespin) Reset() {
+	*x = WildRespin{}
+	mi := &file_engine_v1_engine_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WildRespin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WildRespin) ProtoMessage() {}
+
+func (x *WildRespin) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WildRespin.ProtoReflect.Descriptor instead.
+func (*WildRespin) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WildRespin) GetGrid() *Grid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *WildRespin) GetStops() []int64 {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *WildRespin) GetWins() []*WinLine {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+func (x *WildRespin) GetWin() int64 {
+	if x != nil {
+		return x.Win
+	}
+	return 0
+}
+
+func (x *WildRespin) GetWalking() []*WildCell {
+	if x != nil {
+		return x.Walking
+	}
+	return nil
+}
+
+func (x *WildRespin) GetExpandedReels() []int32 {
+	if x != nil {
+		return x.ExpandedReels
+	}
+	return nil
+}
+
+func (x *WildRespin) GetRngAuditId() string {
+	if x != nil {
+		return x.RngAuditId
+	}
+	return ""
+}
+
+func (x *WildRespin) GetPreRevealGrid() *Grid {
+	if x != nil {
+		return x.PreRevealGrid
+	}
+	return nil
+}
+
+func (x *WildRespin) GetMystery() *MysteryReveal {
+	if x != nil {
+		return x.Mystery
+	}
+	return nil
+}
+
+// Mystery symbols revealed after the reels stopped: every one became the
+// same symbol.
+type MysteryReveal struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Cells that held a mystery symbol.
+	Positions []*Position `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Audit id of the RNG draw that chose symbol.
+	RngAuditId    string `protobuf:"bytes,3,opt,name=rng_audit_id,json=rngAuditId,proto3" json:"rng_audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MysteryReveal) Reset() {
+	*x = MysteryReveal{}
+	mi := &file_engine_v1_engine_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MysteryReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MysteryReveal) ProtoMessage() {}
+
+func (x *MysteryReveal) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MysteryReveal.ProtoReflect.Descriptor instead.
+func (*MysteryReveal) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MysteryReveal) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MysteryReveal) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *MysteryReveal) GetRngAuditId() string {
+	if x != nil {
+		return x.RngAuditId
+	}
+	return ""
+}
+
+// A cell on the grid. Reels count from 0 on the left, rows from 0 at the top.
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reel          int32                  `protobuf:"varint,1,opt,name=reel,proto3" json:"reel,omitempty"`
+	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_engine_v1_engine_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Position) GetReel() int32 {
+	if x != nil {
+		return x.Reel
+	}
+	return 0
+}
+
+func (x *Position) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+// The visible symbols of one reel, top to bottom.
+type Reel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reel) Reset() {
+	*x = Reel{}
+	mi := &file_engine_v1_engine_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reel) ProtoMessage() {}
+
+func (x *Reel) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reel.ProtoReflect.Descriptor instead.
+func (*Reel) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Reel) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// The visible symbols of one row, left to right.
+type Row struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	mi := &file_engine_v1_engine_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Row) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// The symbols showing when the reels stop, by reel and by row. Both views
+// hold the same symbols; rows is omitted when reels differ in height, so
+// each reel then holds only its own cells.
+type Grid struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reels         []*Reel                `protobuf:"bytes,1,rep,name=reels,proto3" json:"reels,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grid) Reset() {
+	*x = Grid{}
+	mi := &file_engine_v1_engine_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grid) ProtoMessage() {}
+
+func (x *Grid) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grid.ProtoReflect.Descriptor instead.
+func (*Grid) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Grid) GetReels() []*Reel {
+	if x != nil {
+		return x.Reels
+	}
+	return nil
+}
+
+func (x *Grid) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// A win on one payline, all the ways of one symbol in a ways game, one
+// cluster in a cluster game, or a scatter pay.
+type WinLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Payline id from the game configuration (1-based); 0 for ways, cluster
+	// and scatter wins.
+	LineId int32 `protobuf:"varint,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	// Paying symbol; wilds substituting for it are reported as this symbol.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Number of matching symbols counted from the leftmost reel (reels, for
+	// ways wins), the cluster size, or the number of scatters.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Cells that form the win, in reel order, including substituting wilds.
+	Positions []*Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Multiplier applied to the paytable pay, 1 when none applies. Ways wins
+	// include multiplier wilds in payout instead, per way.
+	Multiplier int64 `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Amount won on this line, in the same units as bet_amount.
+	Payout int64 `protobuf:"varint,6,opt,name=payout,proto3" json:"payout,omitempty"`
+	// Number of ways paid, ways games only.
+	Ways          int64 `protobuf:"varint,7,opt,name=ways,proto3" json:"ways,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WinLine) Reset() {
+	*x = WinLine{}
+	mi := &file_engine_v1_engine_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WinLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WinLine) ProtoMessage() {}
+
+func (x *WinLine) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WinLine.ProtoReflect.Descriptor instead.
+func (*WinLine) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WinLine) GetLineId() int32 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *WinLine) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *WinLine) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WinLine) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *WinLine) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *WinLine) GetPayout() int64 {
+	if x != nil {
+		return x.Payout
+	}
+	return 0
+}
+
+func (x *WinLine) GetWays() int64 {
+	if x != nil {
+		return x.Ways
+	}
+	return 0
+}
+
+// A feature triggered by the round, e.g. free spins from scatters.
+type FeatureTrigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Feature name from the game configuration, e.g. "free_spins".
+	Feature string `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`
+	// Symbol that triggered the feature.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Cells holding the triggering symbols.
+	Positions []*Position `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Feature award, e.g. the number of free spins; 0 when not applicable.
+	Awarded       int32 `protobuf:"varint,4,opt,name=awarded,proto3" json:"awarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureTrigger) Reset() {
+	*x = FeatureTrigger{}
+	mi := &file_engine_v1_engine_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureTrigger) ProtoMessage() {}
+
+func (x *FeatureTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureTrigger.ProtoReflect.Descriptor instead.
+func (*FeatureTrigger) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FeatureTrigger) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *FeatureTrigger) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *FeatureTrigger) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *FeatureTrigger) GetAwarded() int32 {
+	if x != nil {
+		return x.Awarded
+	}
+	return 0
+}
+
+type SpinResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RoundId  string                 `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	GameCode string                 `protobuf:"bytes,2,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"`
+	// Version of the game configuration the round was evaluated against.
+	ConfigVersion string `protobuf:"bytes,3,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	Grid          *Grid  `protobuf:"bytes,4,opt,name=grid,proto3" json:"grid,omitempty"`
+	// Stop index drawn for each reel, in reel order, for replay.
+	Stops []int64 `protobuf:"varint,5,rep,packed,name=stops,proto3" json:"stops,omitempty"`
+	// Sum of all payouts, in the same units as bet_amount.
+	TotalWin int64             `protobuf:"varint,6,opt,name=total_win,json=totalWin,proto3" json:"total_win,omitempty"`
+	Wins     []*WinLine        `protobuf:"bytes,7,rep,name=wins,proto3" json:"wins,omitempty"`
+	Features []*FeatureTrigger `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`
+	// Audit id of the RNG draw behind the stops.
+	RngAuditId string `protobuf:"bytes,9,opt,name=rng_audit_id,json=rngAuditId,proto3" json:"rng_audit_id,omitempty"`
+	// Every cell holding a scatter symbol, whether or not it paid.
+	ScatterPositions []*Position `protobuf:"bytes,10,rep,name=scatter_positions,json=scatterPositions,proto3" json:"scatter_positions,omitempty"`
+	// The free spins feature this round triggered or belongs to, after the
+	// round. Unset for base rounds without one.
+	FreeSpins *FreeSpinsState `protobuf:"bytes,11,opt,name=free_spins,json=freeSpins,proto3" json:"free_spins,omitempty"`
+	// Every evaluation of a cascading round, in order, starting with grid.
+	// wins and total_win then cover all steps; scatters and features count
+	// on the last step's grid. Empty for games without cascades.
+	Cascades []*CascadeStep `protobuf:"bytes,12,rep,name=cascades,proto3" json:"cascades,omitempty"`
+	// Height of each reel this round, in reel order. Games with variable
+	// reel heights draw these from the RNG with the stops.
+	ReelHeights []int32 `protobuf:"varint,13,rep,packed,name=reel_heights,json=reelHeights,proto3" json:"reel_heights,omitempty"`
+	// Ways in play this round, the product of reel_heights; ways games only.
+	Ways int64 `protobuf:"varint,14,opt,name=ways,proto3" json:"ways,omitempty"`
+	// The respins bonus this round triggered, after its coins are locked.
+	// Unset for rounds without one.
+	Respins *RespinsState `protobuf:"bytes,15,opt,name=respins,proto3" json:"respins,omitempty"`
+	// Reels filled by expanding wilds before evaluation; grid shows them
+	// expanded.
+	ExpandedReels []int32 `protobuf:"varint,16,rep,packed,name=expanded_reels,json=expandedReels,proto3" json:"expanded_reels,omitempty"`
+	// Respins awarded by walking wilds, in order. wins and total_win include
+	// them; scatters and features count on grid only.
+	WildRespins []*WildRespin `protobuf:"bytes,17,rep,name=wild_respins,json=wildRespins,proto3" json:"wild_respins,omitempty"`
+	// The grid as the reels stopped, before mystery symbols were revealed;
+	// grid is the grid after the reveal. Both are unset without mysteries.
+	PreRevealGrid *Grid          `protobuf:"bytes,18,opt,name=pre_reveal_grid,json=preRevealGrid,proto3" json:"pre_reveal_grid,omitempty"`
+	Mystery       *MysteryReveal `protobuf:"bytes,19,opt,name=mystery,proto3" json:"mystery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpinResponse) Reset() {
+	*x = SpinResponse{}
+	mi := &file_engine_v1_engine_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpinResponse) ProtoMessage() {}
+
+func (x *SpinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpinResponse.ProtoReflect.Descriptor instead.
+func (*SpinResponse) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SpinResponse) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *SpinResponse) GetGameCode() string {
+	if x != nil {
+		return x.GameCode
+	}
+	return ""
+}
+
+func (x *SpinResponse) GetConfigVersion() string {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return ""
+}
+
+func (x *SpinResponse) GetGrid() *Grid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetStops() []int64 {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetTotalWin() int64 {
+	if x != nil {
+		return x.TotalWin
+	}
+	return 0
+}
+
+func (x *SpinResponse) GetWins() []*WinLine {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetFeatures() []*FeatureTrigger {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetRngAuditId() string {
+	if x != nil {
+		return x.RngAuditId
+	}
+	return ""
+}
+
+func (x *SpinResponse) GetScatterPositions() []*Position {
+	if x != nil {
+		return x.ScatterPositions
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetFreeSpins() *FreeSpinsState {
+	if x != nil {
+		return x.FreeSpins
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetCascades() []*CascadeStep {
+	if x != nil {
+		return x.Cascades
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetReelHeights() []int32 {
+	if x != nil {
+		return x.ReelHeights
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetWays() int64 {
+	if x != nil {
+		return x.Ways
+	}
+	return 0
+}
+
+func (x *SpinResponse) GetRespins() *RespinsState {
+	if x != nil {
+		return x.Respins
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetExpandedReels() []int32 {
+	if x != nil {
+		return x.ExpandedReels
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetWildRespins() []*WildRespin {
+	if x != nil {
+		return x.WildRespins
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetPreRevealGrid() *Grid {
+	if x != nil {
+		return x.PreRevealGrid
+	}
+	return nil
+}
+
+func (x *SpinResponse) GetMystery() *MysteryReveal {
+	if x != nil {
+		return x.Mystery
+	}
+	return nil
+}
+
+// One evaluation in a cascading round: the grid, its wins, and the cells
+// cleared before the next step.
+type CascadeStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Grid  *Grid                  `protobuf:"bytes,1,opt,name=grid,proto3" json:"grid,omitempty"`
+	Wins  []*WinLine             `protobuf:"bytes,2,rep,name=wins,proto3" json:"wins,omitempty"`
+	// Cascade multiplier for this step, already included in the wins.
+	Multiplier int64 `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Win        int64 `protobuf:"varint,4,opt,name=win,proto3" json:"win,omitempty"`
+	// Cells cleared after this step; the symbols above drop into the gaps.
+	Removed []*Position `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`
+	// Audit id of the RNG draw that filled this step's grid, for games that
+	// refill from the RNG rather than the reel strip.
+	RngAuditId    string `protobuf:"bytes,6,opt,name=rng_audit_id,json=rngAuditId,proto3" json:"rng_audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CascadeStep) Reset() {
+	*x = CascadeStep{}
+	mi := &file_engine_v1_engine_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CascadeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CascadeStep) ProtoMessage() {}
+
+func (x *CascadeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_v1_engine_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CascadeStep.ProtoReflect.Descriptor instead.
+func (*CascadeStep) Descriptor() ([]byte, []int) {
+	return file_engine_v1_engine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CascadeStep) GetGrid() *Grid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *CascadeStep) GetWins() []*WinLine {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+func (x *CascadeStep) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *CascadeStep) GetWin() int64 {
+	if x != nil {
+		return x.Win
+	}
+	return 0
+}
+
+func (x *CascadeStep) GetRemoved() []*Position {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *CascadeStep) GetRngAuditId() string {
+	if x != nil {
+		return x.RngAuditId
+	}
+	return ""
+}
+
+var File_engine_v1_engine_proto protoreflect.FileDescriptor
+
+const file_engine_v1_engine_proto_rawDesc = "" +
+	"\n" +
+	"\x16engine/v1/engine.proto\x12\tengine.v1\"\x81\x01\n" +
+	"\vSpinRequest\x12\x1b\n" +
+	"\tgame_code\x18\x01 \x01(\tR\bgameCode\x12\x1d\n" +
+	"\n" +
+	"bet_amount\x18\x02 \x01(\x03R\tbetAmount\x12\x19\n" +
+	"\bround_id\x18\x03 \x01(\tR\aroundId\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\tR\bplayerId\"O\n" +
+	"\x0fFreeSpinRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"spin_index\x18\x02 \x01(\x05R\tspinIndex\"Q\n" +
+	"\x13GetFreeSpinsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\x96\x03\n" +
+	"\x0eFreeSpinsState\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tgame_code\x18\x02 \x01(\tR\bgameCode\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x19\n" +
+	"\bround_id\x18\x04 \x01(\tR\aroundId\x12\x1d\n" +
+	"\n" +
+	"bet_amount\x18\x05 \x01(\x03R\tbetAmount\x12#\n" +
+	"\rspins_awarded\x18\x06 \x01(\x05R\fspinsAwarded\x12!\n" +
+	"\fspins_played\x18\a \x01(\x05R\vspinsPlayed\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\b \x01(\x03R\n" +
+	"multiplier\x12\x1b\n" +
+	"\ttotal_win\x18\t \x01(\x03R\btotalWin\x12\x1c\n" +
+	"\tcompleted\x18\n" +
+	" \x01(\bR\tcompleted\x12\x16\n" +
+	"\x06voided\x18\v \x01(\bR\x06voided\x126\n" +
+	"\fsticky_wilds\x18\f \x03(\v2\x13.engine.v1.WildCellR\vstickyWilds\"Q\n" +
+	"\rRespinRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\frespin_index\x18\x02 \x01(\x05R\vrespinIndex\"O\n" +
+	"\x11GetRespinsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"M\n" +
+	"\x04Coin\x12/\n" +
+	"\bposition\x18\x01 \x01(\v2\x13.engine.v1.PositionR\bposition\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\"\xc7\x03\n" +
+	"\fRespinsState\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tgame_code\x18\x02 \x01(\tR\bgameCode\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x19\n" +
+	"\bround_id\x18\x04 \x01(\tR\aroundId\x12\x1d\n" +
+	"\n" +
+	"bet_amount\x18\x05 \x01(\x03R\tbetAmount\x12!\n" +
+	"\frespins_left\x18\x06 \x01(\x05R\vrespinsLeft\x12%\n" +
+	"\x0erespins_played\x18\a \x01(\x05R\rrespinsPlayed\x12\x12\n" +
+	"\x04rows\x18\b \x01(\x05R\x04rows\x12\x14\n" +
+	"\x05reels\x18\t \x01(\x05R\x05reels\x12%\n" +
+	"\x05coins\x18\n" +
+	" \x03(\v2\x0f.engine.v1.CoinR\x05coins\x12\x14\n" +
+	"\x05grand\x18\v \x01(\bR\x05grand\x12\x1b\n" +
+	"\ttotal_win\x18\f \x01(\x03R\btotalWin\x12\x1c\n" +
+	"\tcompleted\x18\r \x01(\bR\tcompleted\x12 \n" +
+	"\frng_audit_id\x18\x0e \x01(\tR\n" +
+	"rngAuditId\x12\x16\n" +
+	"\x06voided\x18\x0f \x01(\bR\x06voided\"\x87\x01\n" +
+	"\x0eRespinResponse\x12\x19\n" +
+	"\bround_id\x18\x01 \x01(\tR\aroundId\x12'\n" +
+	"\x06landed\x18\x02 \x03(\v2\x0f.engine.v1.CoinR\x06landed\x121\n" +
+	"\arespins\x18\x03 \x01(\v2\x17.engine.v1.RespinsStateR\arespins\"S\n" +
+	"\bWildCell\x12/\n" +
+	"\bposition\x18\x01 \x01(\v2\x13.engine.v1.PositionR\bposition\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\"\xe6\x02\n" +
+	"\n" +
+	"WildRespin\x12#\n" +
+	"\x04grid\x18\x01 \x01(\v2\x0f.engine.v1.GridR\x04grid\x12\x14\n" +
+	"\x05stops\x18\x02 \x03(\x03R\x05stops\x12&\n" +
+	"\x04wins\x18\x03 \x03(\v2\x12.engine.v1.WinLineR\x04wins\x12\x10\n" +
+	"\x03win\x18\x04 \x01(\x03R\x03win\x12-\n" +
+	"\awalking\x18\x05 \x03(\v2\x13.engine.v1.WildCellR\awalking\x12%\n" +
+	"\x0eexpanded_reels\x18\x06 \x03(\x05R\rexpandedReels\x12 \n" +
+	"\frng_audit_id\x18\a \x01(\tR\n" +
+	"rngAuditId\x127\n" +
+	"\x0fpre_reveal_grid\x18\b \x01(\v2\x0f.engine.v1.GridR\rpreRevealGrid\x122\n" +
+	"\amystery\x18\t \x01(\v2\x18.engine.v1.MysteryRevealR\amystery\"|\n" +
+	"\rMysteryReveal\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x121\n" +
+	"\tpositions\x18\x02 \x03(\v2\x13.engine.v1.PositionR\tpositions\x12 \n" +
+	"\frng_audit_id\x18\x03 \x01(\tR\n" +
+	"rngAuditId\"0\n" +
+	"\bPosition\x12\x12\n" +
+	"\x04reel\x18\x01 \x01(\x05R\x04reel\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x05R\x03row\" \n" +
+	"\x04Reel\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"\x1f\n" +
+	"\x03Row\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"Q\n" +
+	"\x04Grid\x12%\n" +
+	"\x05reels\x18\x01 \x03(\v2\x0f.engine.v1.ReelR\x05reels\x12\"\n" +
+	"\x04rows\x18\x02 \x03(\v2\x0e.engine.v1.RowR\x04rows\"\xcf\x01\n" +
+	"\aWinLine\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\x05R\x06lineId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x121\n" +
+	"\tpositions\x18\x04 \x03(\v2\x13.engine.v1.PositionR\tpositions\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x05 \x01(\x03R\n" +
+	"multiplier\x12\x16\n" +
+	"\x06payout\x18\x06 \x01(\x03R\x06payout\x12\x12\n" +
+	"\x04ways\x18\a \x01(\x03R\x04ways\"\x8f\x01\n" +
+	"\x0eFeatureTrigger\x12\x18\n" +
+	"\afeature\x18\x01 \x01(\tR\afeature\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x121\n" +
+	"\tpositions\x18\x03 \x03(\v2\x13.engine.v1.PositionR\tpositions\x12\x18\n" +
+	"\aawarded\x18\x04 \x01(\x05R\aawarded\"\xae\x06\n" +
+	"\fSpinResponse\x12\x19\n" +
+	"\bround_id\x18\x01 \x01(\tR\aroundId\x12\x1b\n" +
+	"\tgame_code\x18\x02 \x01(\tR\bgameCode\x12%\n" +
+	"\x0econfig_version\x18\x03 \x01(\tR\rconfigVersion\x12#\n" +
+	"\x04grid\x18\x04 \x01(\v2\x0f.engine.v1.GridR\x04grid\x12\x14\n" +
+	"\x05stops\x18\x05 \x03(\x03R\x05stops\x12\x1b\n" +
+	"\ttotal_win\x18\x06 \x01(\x03R\btotalWin\x12&\n" +
+	"\x04wins\x18\a \x03(\v2\x12.engine.v1.WinLineR\x04wins\x125\n" +
+	"\bfeatures\x18\b \x03(\v2\x19.engine.v1.FeatureTriggerR\bfeatures\x12 \n" +
+	"\frng_audit_id\x18\t \x01(\tR\n" +
+	"rngAuditId\x12@\n" +
+	"\x11scatter_positions\x18\n" +
+	" \x03(\v2\x13.engine.v1.PositionR\x10scatterPositions\x128\n" +
+	"\n" +
+	"free_spins\x18\v \x01(\v2\x19.engine.v1.FreeSpinsStateR\tfreeSpins\x122\n" +
+	"\bcascades\x18\f \x03(\v2\x16.engine.v1.CascadeStepR\bcascades\x12!\n" +
+	"\freel_heights\x18\r \x03(\x05R\vreelHeights\x12\x12\n" +
+	"\x04ways\x18\x0e \x01(\x03R\x04ways\x121\n" +
+	"\arespins\x18\x0f \x01(\v2\x17.engine.v1.RespinsStateR\arespins\x12%\n" +
+	"\x0eexpanded_reels\x18\x10 \x03(\x05R\rexpandedReels\x128\n" +
+	"\fwild_respins\x18\x11 \x03(\v2\x15.engine.v1.WildRespinR\vwildRespins\x127\n" +
+	"\x0fpre_reveal_grid\x18\x12 \x01(\v2\x0f.engine.v1.GridR\rpreRevealGrid\x122\n" +
+	"\amystery\x18\x13 \x01(\v2\x18.engine.v1.MysteryRevealR\amystery\"\xdd\x01\n" +
+	"\vCascadeStep\x12#\n" +
+	"\x04grid\x18\x01 \x01(\v2\x0f.engine.v1.GridR\x04grid\x12&\n" +
+	"\x04wins\x18\x02 \x03(\v2\x12.engine.v1.WinLineR\x04wins\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x03R\n" +
+	"multiplier\x12\x10\n" +
+	"\x03win\x18\x04 \x01(\x03R\x03win\x12-\n" +
+	"\aremoved\x18\x05 \x03(\v2\x13.engine.v1.PositionR\aremoved\x12 \n" +
+	"\frng_audit_id\x18\x06 \x01(\tR\n" +
+	"rngAuditId2\xdc\x02\n" +
+	"\x11GameEngineService\x127\n" +
+	"\x04Spin\x12\x16.engine.v1.SpinRequest\x1a\x17.engine.v1.SpinResponse\x12?\n" +
+	"\bFreeSpin\x12\x1a.engine.v1.FreeSpinRequest\x1a\x17.engine.v1.SpinResponse\x12I\n" +
+	"\fGetFreeSpins\x12\x1e.engine.v1.GetFreeSpinsRequest\x1a\x19.engine.v1.FreeSpinsState\x12=\n" +
+	"\x06Respin\x12\x18.engine.v1.RespinRequest\x1a\x19.engine.v1.RespinResponse\x12C\n" +
+	"\n" +
+	"GetRespins\x12\x1c.engine.v1.GetRespinsRequest\x1a\x17.engine.v1.RespinsStateB\\ZZgithub.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1;enginev1b\x06proto3"
+
+var (
+	file_engine_v1_engine_proto_rawDescOnce sync.Once
+	file_engine_v1_engine_proto_rawDescData []byte
+)
+
+func file_engine_v1_engine_proto_rawDescGZIP() []byte {
+	file_engine_v1_engine_proto_rawDescOnce.Do(func() {
+		file_engine_v1_engine_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_engine_v1_engine_proto_rawDesc), len(file_engine_v1_engine_proto_rawDesc)))
+	})
+	return file_engine_v1_engine_proto_rawDescData
+}
+
+var file_engine_v1_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_engine_v1_engine_proto_goTypes = []any{
+	(*SpinRequest)(nil),         // 0: engine.v1.SpinRequest
+	(*FreeSpinRequest)(nil),     // 1: engine.v1.FreeSpinRequest
+	(*GetFreeSpinsRequest)(nil), // 2: engine.v1.GetFreeSpinsRequest
+	(*FreeSpinsState)(nil),      // 3: engine.v1.FreeSpinsState
+	(*RespinRequest)(nil),       // 4: engine.v1.RespinRequest
+	(*GetRespinsRequest)(nil),   // 5: engine.v1.GetRespinsRequest
+	(*Coin)(nil),                // 6: engine.v1.Coin
+	(*RespinsState)(nil),        // 7: engine.v1.RespinsState
+	(*RespinResponse)(nil),      // 8: engine.v1.RespinResponse
+	(*WildCell)(nil),            // 9: engine.v1.WildCell
+	(*WildRespin)(nil),          // 10: engine.v1.WildRespin
+	(*MysteryReveal)(nil),       // 11: engine.v1.MysteryReveal
+	(*Position)(nil),            // 12: engine.v1.Position
+	(*Reel)(nil),                // 13: engine.v1.Reel
+	(*Row)(nil),                 // 14: engine.v1.Row
+	(*Grid)(nil),                // 15: engine.v1.Grid
+	(*WinLine)(nil),             // 16: engine.v1.WinLine
+	(*FeatureTrigger)(nil),      // 17: engine.v1.FeatureTrigger
+	(*SpinResponse)(nil),        // 18: engine.v1.SpinResponse
+	(*CascadeStep)(nil),         // 19: engine.v1.CascadeStep
+}
+var file_engine_v1_engine_proto_depIdxs = []int32{
+	9,  // 0: engine.v1.FreeSpinsState.sticky_wilds:type_name -> engine.v1.WildCell
+	12, // 1: engine.v1.Coin.position:type_name -> engine.v1.Position
+	6,  // 2: engine.v1.RespinsState.coins:type_name -> engine.v1.Coin
+	6,  // 3: engine.v1.RespinResponse.landed:type_name -> engine.v1.Coin
+	7,  // 4: engine.v1.RespinResponse.respins:type_name -> engine.v1.RespinsState
+	12, // 5: engine.v1.WildCell.position:type_name -> engine.v1.Position
+	15, // 6: engine.v1.WildRespin.grid:type_name -> engine.v1.Grid
+	16, // 7: engine.v1.WildRespin.wins:type_name -> engine.v1.WinLine
+	9,  // 8: engine.v1.WildRespin.walking:type_name -> engine.v1.WildCell
+	15, // 9: engine.v1.WildRespin.pre_reveal_grid:type_name -> engine.v1.Grid
+	11, // 10: engine.v1.WildRespin.mystery:type_name -> engine.v1.MysteryReveal
+	12, // 11: engine.v1.MysteryReveal.positions:type_name -> engine.v1.Position
+	13, // 12: engine.v1.Grid.reels:type_name -> engine.v1.Reel
+	14, // 13: engine.v1.Grid.rows:type_name -> engine.v1.Row
+	12, // 14: engine.v1.WinLine.positions:type_name -> engine.v1.Position
+	12, // 15: engine.v1.FeatureTrigger.positions:type_name -> engine.v1.Position
+	15, // 16: engine.v1.SpinResponse.grid:type_name -> engine.v1.Grid
+	16, // 17: engine.v1.SpinResponse.wins:type_name -> engine.v1.WinLine
+	17, // 18: engine.v1.SpinResponse.features:type_name -> engine.v1.FeatureTrigger
+	12, // 19: engine.v1.SpinResponse.scatter_positions:type_name -> engine.v1.Position
+	3,  // 20: engine.v1.SpinResponse.free_spins:type_name -> engine.v1.FreeSpinsState
+	19, // 21: engine.v1.SpinResponse.cascades:type_name -> engine.v1.CascadeStep
+	7,  // 22: engine.v1.SpinResponse.respins:type_name -> engine.v1.RespinsState
+	10, // 23: engine.v1.SpinResponse.wild_respins:type_name -> engine.v1.WildRespin
+	15, // 24: engine.v1.SpinResponse.pre_reveal_grid:type_name -> engine.v1.Grid
+	11, // 25: engine.v1.SpinResponse.mystery:type_name -> engine.v1.MysteryReveal
+	15, // 26: engine.v1.CascadeStep.grid:type_name -> engine.v1.Grid
+	16, // 27: engine.v1.CascadeStep.wins:type_name -> engine.v1.WinLine
+	12, // 28: engine.v1.CascadeStep.removed:type_name -> engine.v1.Position
+	0,  // 29: engine.v1.GameEngineService.Spin:input_type -> engine.v1.SpinRequest
+	1,  // 30: engine.v1.GameEngineService.FreeSpin:input_type -> engine.v1.FreeSpinRequest
+	2,  // 31: engine.v1.GameEngineService.GetFreeSpins:input_type -> engine.v1.GetFreeSpinsRequest
+	4,  // 32: engine.v1.GameEngineService.Respin:input_type -> engine.v1.RespinRequest
+	5,  // 33: engine.v1.GameEngineService.GetRespins:input_type -> engine.v1.GetRespinsRequest
+	18, // 34: engine.v1.GameEngineService.Spin:output_type -> engine.v1.SpinResponse
+	18, // 35: engine.v1.GameEngineService.FreeSpin:output_type -> engine.v1.SpinResponse
+	3,  // 36: engine.v1.GameEngineService.GetFreeSpins:output_type -> engine.v1.FreeSpinsState
+	8,  // 37: engine.v1.GameEngineService.Respin:output_type -> engine.v1.RespinResponse
+	7,  // 38: engine.v1.GameEngineService.GetRespins:output_type -> engine.v1.RespinsState
+	34, // [34:39] is the sub-list for method output_type
+	29, // [29:34] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_engine_v1_engine_proto_init() }
+func file_engine_v1_engine_proto_init() {
+	if File_engine_v1_engine_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_engine_v1_engine_proto_rawDesc), len(file_engine_v1_engine_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_engine_v1_engine_proto_goTypes,
+		DependencyIndexes: file_engine_v1_engine_proto_depIdxs,
+		MessageInfos:      file_engine_v1_engine_proto_msgTypes,
+	}.Build()
+	File_engine_v1_engine_proto = out.File
+	file_engine_v1_engine_proto_goTypes = nil
+	file_engine_v1_engine_proto_depIdxs = nil
+}
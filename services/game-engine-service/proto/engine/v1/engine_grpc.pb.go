@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: engine/v1/engine.proto
+
+package enginev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameEngineService_Spin_FullMethodName         = "/engine.v1.GameEngineService/Spin"
+	GameEngineService_FreeSpin_FullMethodName     = "/engine.v1.GameEngineService/FreeSpin"
+	GameEngineService_GetFreeSpins_FullMethodName = "/engine.v1.GameEngineService/GetFreeSpins"
+	GameEngineService_Respin_FullMethodName       = "/engine.v1.GameEngineService/Respin"
+	GameEngineService_GetRespins_FullMethodName   = "/engine.v1.GameEngineService/GetRespins"
+)
+
+// GameEngineServiceClient is the client API for GameEngineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameEngineServiceClient interface {
+	// Plays one round: draws the reel stops from the RNG service and evaluates
+	// the resulting grid against the game's configuration.
+	Spin(ctx context.Context, in *SpinRequest, opts ...grpc.CallOption) (*SpinResponse, error)
+	// Plays the next spin of a free spins feature triggered by Spin. Free
+	// spins are not staked: the feature's bet is the triggering round's, and
+	// its wins accumulate in the returned state until it completes.
+	FreeSpin(ctx context.Context, in *FreeSpinRequest, opts ...grpc.CallOption) (*SpinResponse, error)
+	// Returns a free spins feature, e.g. to resume it after a reconnect.
+	GetFreeSpins(ctx context.Context, in *GetFreeSpinsRequest, opts ...grpc.CallOption) (*FreeSpinsState, error)
+	// Plays the next respin of a hold-and-spin bonus triggered by Spin. Like
+	// free spins, respins are not staked; the bonus pays total_win once it
+	// completes.
+	Respin(ctx context.Context, in *RespinRequest, opts ...grpc.CallOption) (*RespinResponse, error)
+	// Returns a respins bonus, e.g. to resume it after a reconnect.
+	GetRespins(ctx context.Context, in *GetRespinsRequest, opts ...grpc.CallOption) (*RespinsState, error)
+}
+
+type gameEngineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameEngineServiceClient(cc grpc.ClientConnInterface) GameEngineServiceClient {
+	return &gameEngineServiceClient{cc}
+}
+
+func (c *gameEngineServiceClient) Spin(ctx context.Context, in *SpinRequest, opts ...grpc.CallOption) (*SpinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpinResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_Spin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) FreeSpin(ctx context.Context, in *FreeSpinRequest, opts ...grpc.CallOption) (*SpinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpinResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_FreeSpin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) GetFreeSpins(ctx context.Context, in *GetFreeSpinsRequest, opts ...grpc.CallOption) (*FreeSpinsState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreeSpinsState)
+	err := c.cc.Invoke(ctx, GameEngineService_GetFreeSpins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) Respin(ctx context.Context, in *RespinRequest, opts ...grpc.CallOption) (*RespinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespinResponse)
+	err := c.cc.Invoke(ctx, GameEngineService_Respin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameEngineServiceClient) GetRespins(ctx context.Context, in *GetRespinsRequest, opts ...grpc.CallOption) (*RespinsState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespinsState)
+	err := c.cc.Invoke(ctx, GameEngineService_GetRespins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameEngineServiceServer is the server API for GameEngineService service.
+// All implementations must embed UnimplementedGameEngineServiceServer
+// for forward compatibility.
+type GameEngineServiceServer interface {
+	// Plays one round: draws the reel stops from the RNG service and evaluates
+	// the resulting grid against the game's configuration.
+	Spin(context.Context, *SpinRequest) (*SpinResponse, error)
+	// Plays the next spin of a free spins feature triggered by Spin. Free
+	// spins are not staked: the feature's bet is the triggering round's, and
+	// its wins accumulate in the returned state until it completes.
+	FreeSpin(context.Context, *FreeSpinRequest) (*SpinResponse, error)
+	// Returns a free spins feature, e.g. to resume it after a reconnect.
+	GetFreeSpins(context.Context, *GetFreeSpinsRequest) (*FreeSpinsState, error)
+	// Plays the next respin of a hold-and-spin bonus triggered by Spin. Like
+	// free spins, respins are not staked; the bonus pays total_win once it
+	// completes.
+	Respin(context.Context, *RespinRequest) (*RespinResponse, error)
+	// Returns a respins bonus, e.g. to resume it after a reconnect.
+	GetRespins(context.Context, *GetRespinsRequest) (*RespinsState, error)
+	mustEmbedUnimplementedGameEngineServiceServer()
+}
+
+// UnimplementedGameEngineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameEngineServiceServer struct{}
+
+func (UnimplementedGameEngineServiceServer) Spin(context.Context, *SpinRequest) (*SpinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spin not implemented")
+}
+func (UnimplementedGameEngineServiceServer) FreeSpin(context.Context, *FreeSpinRequest) (*SpinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeSpin not implemented")
+}
+func (UnimplementedGameEngineServiceServer) GetFreeSpins(context.Context, *GetFreeSpinsRequest) (*FreeSpinsState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeSpins not implemented")
+}
+func (UnimplementedGameEngineServiceServer) Respin(context.Context, *RespinRequest) (*RespinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Respin not implemented")
+}
+func (UnimplementedGameEngineServiceServer) GetRespins(context.Context, *GetRespinsRequest) (*RespinsState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRespins not implemented")
+}
+func (UnimplementedGameEngineServiceServer) mustEmbedUnimplementedGameEngineServiceServer() {}
+func (UnimplementedGameEngineServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeGameEngineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameEngineServiceServer will
+// result in compilation errors.
+type UnsafeGameEngineServiceServer interface {
+	mustEmbedUnimplementedGameEngineServiceServer()
+}
+
+func RegisterGameEngineServiceServer(s grpc.ServiceRegistrar, srv GameEngineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGameEngineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameEngineService_ServiceDesc, srv)
+}
+
+func _GameEngineService_Spin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).Spin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_Spin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).Spin(ctx, req.(*SpinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_FreeSpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreeSpinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).FreeSpin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_FreeSpin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).FreeSpin(ctx, req.(*FreeSpinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_GetFreeSpins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreeSpinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).GetFreeSpins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_GetFreeSpins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).GetFreeSpins(ctx, req.(*GetFreeSpinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_Respin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).Respin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_Respin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).Respin(ctx, req.(*RespinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameEngineService_GetRespins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRespinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameEngineServiceServer).GetRespins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameEngineService_GetRespins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameEngineServiceServer).GetRespins(ctx, req.(*GetRespinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameEngineService_ServiceDesc is the grpc.ServiceDesc for GameEngineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameEngineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "engine.v1.GameEngineService",
+	HandlerType: (*GameEngineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Spin",
+			Handler:    _GameEngineService_Spin_Handler,
+		},
+		{
+			MethodName: "FreeSpin",
+			Handler:    _GameEngineService_FreeSpin_Handler,
+		},
+		{
+			MethodName: "GetFreeSpins",
+			Handler:    _GameEngineService_GetFreeSpins_Handler,
+		},
+		{
+			MethodName: "Respin",
+			Handler:    _GameEngineService_Respin_Handler,
+		},
+		{
+			MethodName: "GetRespins",
+			Handler:    _GameEngineService_GetRespins_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine/v1/engine.proto",
+}
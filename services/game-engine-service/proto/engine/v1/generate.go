@@ -0,0 +1,4 @@
+// Package enginev1 holds the generated code for the engine.v1 gRPC API.
+package enginev1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative engine/v1/engine.proto
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
+)
+
+// startRespins locks the coins of a base round that triggered the respins
+// bonus and persists the bonus. It returns nil when the round awarded none.
+func (s *engineServer) startRespins(ctx context.Context, cfg *GameConfig, playerID, roundID string, betAmount int, result SpinResult) (*FeatureSession, error) {
+	st, err := cfg.lockCoins(result, betAmount, s.rngFill(ctx, cfg, roundID))
+	if err != nil || st == nil {
+		return nil, err
+	}
+	id, err := newRoundID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sessions.pinConfig(cfg); err != nil {
+		return nil, err
+	}
+	sess := &FeatureSession{
+		ID:            id,
+		Feature:       FeatureRespins,
+		GameCode:      cfg.GameCode,
+		ConfigVersion: cfg.Version,
+		PlayerID:      playerID,
+		RoundID:       roundID,
+		BetAmount:     betAmount,
+		TotalWin:      cfg.respinsWin(st, betAmount),
+		Completed:     st.Left == 0,
+		Respins:       st,
+	}
+	if err := s.sessions.save(sess); err != nil {
+		return nil, err
+	}
+	log.Printf("Round %s started respins with %d coins (session %s)", roundID, len(st.Coins), sess.ID)
+	return sess, nil
+}
+
+func (s *engineServer) Respin(ctx context.Context, req *pb_engine.RespinRequest) (*pb_engine.RespinResponse, error) {
+	unlock := s.sessions.lock(req.GetSessionId())
+	defer unlock()
+
+	sess, err := s.loadSession(req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	if sess.Feature != FeatureRespins {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is not a respins session", sess.ID)
+	}
+	cfg, err := s.sessionConfig(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	st := sess.Respins
+	index := int(req.GetRespinIndex())
+	if last := st.LastRespin; last != nil && index == st.Played-1 {
+		return &pb_engine.RespinResponse{
+			RoundId: last.RoundID,
+			Landed:  toCoins(last.Landed),
+			Respins: toRespinsState(cfg, sess),
+		}, nil
+	}
+	if sess.Completed {
+		return nil, status.Errorf(codes.FailedPrecondition, "respins session %s is complete", sess.ID)
+	}
+	if index != st.Played {
+		return nil, status.Errorf(codes.FailedPrecondition, "next respin_index is %d, got %d", st.Played, index)
+	}
+
+	roundID := fmt.Sprintf("%s-rs%d", sess.RoundID, st.Played+1)
+	landed, err := cfg.respin(st, sess.BetAmount, s.rngFill(ctx, cfg, roundID))
+	if err != nil {
+		log.Printf("Round %s failed to resolve: %v", roundID, err)
+		return nil, status.Error(codes.Internal, "failed to resolve respin")
+	}
+	st.LastRespin = &playedRespin{RoundID: roundID, Landed: landed}
+	sess.TotalWin = cfg.respinsWin(st, sess.BetAmount)
+	sess.Completed = st.Left == 0
+	if err := s.sessions.save(sess); err != nil {
+		log.Printf("Round %s: failed to save session %s: %v", roundID, sess.ID, err)
+		return nil, status.Error(codes.Internal, "failed to save respins state")
+	}
+	if sess.Completed {
+		log.Printf("Respins session %s complete, total win %d (grand %t)", sess.ID, sess.TotalWin, st.Grand)
+	}
+
+	return &pb_engine.RespinResponse{
+		RoundId: roundID,
+		Landed:  toCoins(landed),
+		Respins: toRespinsState(cfg, sess),
+	}, nil
+}
+
+func (s *engineServer) GetRespins(ctx context.Context, req *pb_engine.GetRespinsRequest) (*pb_engine.RespinsState, error) {
+	id := req.GetSessionId()
+	if id == "" {
+		if req.GetPlayerId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "session_id or player_id is required")
+		}
+		var ok bool
+		if id, ok = s.sessions.activeFor(req.GetPlayerId()); !ok {
+			return nil, status.Errorf(codes.NotFound, "player %q has no respins in progress", req.GetPlayerId())
+		}
+	}
+	sess, err := s.loadSession(id)
+	if err != nil {
+		return nil, err
+	}
+	if sess.Feature != FeatureRespins {
+		return nil, status.Errorf(codes.NotFound, "session %s is not a respins session", sess.ID)
+	}
+	if sess.Voided {
+		return toRespinsState(nil, sess), nil
+	}
+	cfg, err := s.sessionConfig(sess)
+	if err != nil {
+		return nil, err
+	}
+	return toRespinsState(cfg, sess), nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	pb_engine "github.com/ShadyDevelopment/ECHOBETZ/services/game-engine-service/proto/engine/v1"
+)
+
+// newRoundID returns a random round id for requests that do not supply one.
+func newRoundID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// toGrid converts a rows x reels matrix to the proto grid, in both the
+// per-reel and per-row views. Empty cells below a shorter reel are left
+// out, so reels of a variable-height grid are jagged and have no rows view.
+func toGrid(matrix [][]string) *pb_engine.Grid {
+	grid := &pb_engine.Grid{}
+	jagged := false
+	for r, row := range matrix {
+		for c, symbol := range row {
+			if r == 0 {
+				grid.Reels = append(grid.Reels, &pb_engine.Reel{})
+			}
+			if symbol == "" {
+				jagged = true
+				continue
+			}
+			grid.Reels[c].Symbols = append(grid.Reels[c].Symbols, symbol)
+		}
+	}
+	if jagged {
+		return grid
+	}
+	for _, row := range matrix {
+		grid.Rows = append(grid.Rows, &pb_engine.Row{Symbols: row})
+	}
+	return grid
+}
+
+func toPositions(positions []Position) []*pb_engine.Position {
+	out := make([]*pb_engine.Position, len(positions))
+	for i, p := range positions {
+		out[i] = &pb_engine.Position{Reel: int32(p.Reel), Row: int32(p.Row)}
+	}
+	return out
+}
+
+// toSpinResponse converts an evaluated round to its wire form.
+func toSpinResponse(cfg *GameConfig, roundID, auditID string, result SpinResult) *pb_engine.SpinResponse {
+	resp := &pb_engine.SpinResponse{
+		RoundId:          roundID,
+		GameCode:         cfg.GameCode,
+		ConfigVersion:    cfg.Version,
+		Grid:             toGrid(result.Matrix),
+		Stops:            result.Stops,
+		TotalWin:         int64(result.TotalWin),
+		Wins:             toWinLines(result.WinLines),
+		RngAuditId:       auditID,
+		ScatterPositions: toPositions(result.ScatterPositions),
+	}
+	resp.ExpandedReels = toReels(result.Expanded)
+	if result.Mystery != nil {
+		resp.PreRevealGrid = toGrid(result.PreRevealMatrix)
+		resp.Mystery = toMysteryReveal(result.Mystery)
+	}
+	for _, rs := range result.WildRespins {
+		resp.WildRespins = append(resp.WildRespins, &pb_engine.WildRespin{
+			Grid:          toGrid(rs.Matrix),
+			Stops:         rs.Stops,
+			Wins:          toWinLines(rs.WinLines),
+			Win:           int64(rs.Win),
+			Walking:       toWildCells(rs.Walking),
+			ExpandedReels: toReels(rs.Expanded),
+			RngAuditId:    rs.AuditID,
+		})
+		if rs.Mystery != nil {
+			last := resp.WildRespins[len(resp.WildRespins)-1]
+			last.PreRevealGrid = toGrid(rs.PreRevealMatrix)
+			last.Mystery = toMysteryReveal(rs.Mystery)
+		}
+	}
+	for _, h := range result.Heights {
+		resp.ReelHeights = append(resp.ReelHeights, int32(h))
+	}
+	if cfg.Evaluation == EvalWays {
+		resp.Ways = int64(ways(result.Heights))
+	}
+	for _, f := range result.Features {
+		resp.Features = append(resp.Features, &pb_engine.FeatureTrigger{
+			Feature:   f.Feature,
+			Symbol:    f.Symbol,
+			Positions: toPositions(f.Positions),
+			Awarded:   int32(f.Awarded),
+		})
+	}
+	for _, step := range result.Cascades {
+		resp.Cascades = append(resp.Cascades, &pb_engine.CascadeStep{
+			Grid:       toGrid(step.Matrix),
+			Wins:       toWinLines(step.WinLines),
+			Multiplier: int64(step.Multiplier),
+			Win:        int64(step.Win),
+			Removed:    toPositions(step.Removed),
+			RngAuditId: step.AuditID,
+		})
+	}
+	return resp
+}
+
+func toWinLines(wins []WinLine) []*pb_engine.WinLine {
+	var out []*pb_engine.WinLine
+	for _, w := range wins {
+		out = append(out, &pb_engine.WinLine{
+			LineId:     int32(w.LineID),
+			Symbol:     w.Symbol,
+			Count:      int32(w.Count),
+			Positions:  toPositions(w.Positions),
+			Multiplier: int64(w.Multiplier),
+			Payout:     int64(w.Payout),
+			Ways:       int64(w.Ways),
+		})
+	}
+	return out
+}
+
+func toFreeSpinsState(sess *FeatureSession) *pb_engine.FreeSpinsState {
+	return &pb_engine.FreeSpinsState{
+		SessionId:    sess.ID,
+		GameCode:     sess.GameCode,
+		PlayerId:     sess.PlayerID,
+		RoundId:      sess.RoundID,
+		BetAmount:    int64(sess.BetAmount),
+		SpinsAwarded: int32(sess.SpinsAwarded),
+		SpinsPlayed:  int32(sess.SpinsPlayed),
+		Multiplier:   int64(sess.Multiplier),
+		TotalWin:     int64(sess.TotalWin),
+		Completed:    sess.Completed,
+		StickyWilds:  toWildCells(sess.Sticky),
+		Voided:       sess.Voided,
+	}
+}
+
+func toMysteryReveal(m *MysteryReveal) *pb_engine.MysteryReveal {
+	return &pb_engine.MysteryReveal{
+		Symbol:     m.Symbol,
+		Positions:  toPositions(m.Positions),
+		RngAuditId: m.AuditID,
+	}
+}
+
+func toReels(reels []int) []int32 {
+	var out []int32
+	for _, r := range reels {
+		out = append(out, int32(r))
+	}
+	return out
+}
+
+func toWildCells(cells []WildCell) []*pb_engine.WildCell {
+	var out []*pb_engine.WildCell
+	for _, c := range cells {
+		out = append(out, &pb_engine.WildCell{
+			Position: &pb_engine.Position{Reel: int32(c.Position.Reel), Row: int32(c.Position.Row)},
+			Symbol:   c.Symbol,
+		})
+	}
+	return out
+}
+
+func toCoins(coins []Coin) []*pb_engine.Coin {
+	var out []*pb_engine.Coin
+	for _, c := range coins {
+		out = append(out, &pb_engine.Coin{
+			Position: &pb_engine.Position{Reel: int32(c.Position.Reel), Row: int32(c.Position.Row)},
+			Value:    int64(c.Value),
+		})
+	}
+	return out
+}
+
+// toRespinsState converts a respins session to its wire form. cfg gives the
+// grid size and may be nil for a voided session.
+func toRespinsState(cfg *GameConfig, sess *FeatureSession) *pb_engine.RespinsState {
+	st := sess.Respins
+	state := &pb_engine.RespinsState{
+		SessionId:     sess.ID,
+		GameCode:      sess.GameCode,
+		PlayerId:      sess.PlayerID,
+		RoundId:       sess.RoundID,
+		BetAmount:     int64(sess.BetAmount),
+		RespinsLeft:   int32(st.Left),
+		RespinsPlayed: int32(st.Played),
+		Coins:         toCoins(st.Coins),
+		Grand:         st.Grand,
+		TotalWin:      int64(sess.TotalWin),
+		Completed:     sess.Completed,
+		RngAuditId:    st.AuditID,
+		Voided:        sess.Voided,
+	}
+	if cfg != nil {
+		state.Rows = int32(cfg.Grid.Rows)
+		state.Reels = int32(cfg.Grid.Reels)
+	}
+	return state
+}
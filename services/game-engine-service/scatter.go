@@ -0,0 +1,74 @@
+package main
+
+import "sort"
+
+// symbolPositions returns the cells holding symbol, in reel order.
+func symbolPositions(matrix [][]string, symbol string) []Position {
+	var positions []Position
+	for reel := range matrix[0] {
+		for row := range matrix {
+			if matrix[row][reel] == symbol {
+				positions = append(positions, Position{Reel: reel, Row: row})
+			}
+		}
+	}
+	return positions
+}
+
+// evaluateScatters pays each scatter symbol on the number landing anywhere,
+// in total bets. It also returns the cells of every scatter on the grid.
+func (cfg *GameConfig) evaluateScatters(matrix [][]string, betAmount int) ([]WinLine, []Position) {
+	symbols := make([]string, 0, len(cfg.Scatters))
+	for symbol := range cfg.Scatters {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	var wins []WinLine
+	var all []Position
+	for _, symbol := range symbols {
+		positions := symbolPositions(matrix, symbol)
+		all = append(all, positions...)
+		if pay := payAtLeast(cfg.Scatters[symbol], len(positions)); pay > 0 {
+			wins = append(wins, WinLine{
+				Symbol:     symbol,
+				Count:      len(positions),
+				Positions:  positions,
+				Multiplier: 1,
+				Payout:     pay * betAmount,
+			})
+		}
+	}
+	return wins, all
+}
+
+// evaluateTriggers applies the trigger rules to matrix, at most once per
+// feature, in the order the features first appear in the rules.
+func (cfg *GameConfig) evaluateTriggers(matrix [][]string) []FeatureTrigger {
+	best := map[string]TriggerRule{}
+	var order []string
+	for _, rule := range cfg.Triggers {
+		if len(symbolPositions(matrix, rule.Symbol)) < rule.MinCount {
+			continue
+		}
+		current, ok := best[rule.Feature]
+		if !ok {
+			order = append(order, rule.Feature)
+		}
+		if !ok || rule.MinCount > current.MinCount {
+			best[rule.Feature] = rule
+		}
+	}
+
+	var features []FeatureTrigger
+	for _, feature := range order {
+		rule := best[feature]
+		features = append(features, FeatureTrigger{
+			Feature:   feature,
+			Symbol:    rule.Symbol,
+			Positions: symbolPositions(matrix, rule.Symbol),
+			Awarded:   rule.Award,
+		})
+	}
+	return features
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+const scatterConfig = `{
+	"game_code": "scatter", "version": "1",
+	"grid": {"rows": 3, "reels": 3},
+	"paylines": [[0, 0, 0]],
+	"paytable": {"A": {"3": 1}},
+	"wilds": ["W"],
+	"scatters": {"S": {"2": 1, "3": 5}},
+	"triggers": [
+		{"feature": "free_spins", "symbol": "S", "min_count": 3, "award": 10},
+		{"feature": "bonus", "symbol": "S", "min_count": 2, "award": 1},
+		{"feature": "free_spins", "symbol": "S", "min_count": 4, "award": 15}
+	],
+	"reel_strips": [["A"], ["A"], ["A"]]
+}`
+
+// scatterGrid returns a 3x3 grid of C with n scatters, filled in reel
+// order.
+func scatterGrid(n int) [][]string {
+	matrix := [][]string{{"C", "C", "C"}, {"C", "C", "C"}, {"C", "C", "C"}}
+	for i := 0; i < n; i++ {
+		matrix[i%3][i/3] = "S"
+	}
+	return matrix
+}
+
+func TestEvaluateScatters(t *testing.T) {
+	tests := []struct {
+		scatters int
+		pay      int // in total bets
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 1},
+		{3, 5},
+		{4, 5}, // the "3" pay covers larger counts
+	}
+	for _, tt := range tests {
+		cfg := testConfig(t, scatterConfig)
+		wins, positions := cfg.evaluateScatters(scatterGrid(tt.scatters), 10)
+		if len(positions) != tt.scatters {
+			t.Errorf("%d scatters: got %d positions", tt.scatters, len(positions))
+		}
+		got := 0
+		for _, w := range wins {
+			got += w.Payout
+		}
+		if got != 10*tt.pay {
+			t.Errorf("%d scatters: paid %d, want %d", tt.scatters, got, 10*tt.pay)
+		}
+	}
+}
+
+func TestEvaluateTriggers(t *testing.T) {
+	tests := []struct {
+		scatters int
+		want     map[string]int // awarded by feature
+		order    []string
+	}{
+		{1, nil, nil},
+		{2, map[string]int{"bonus": 1}, []string{"bonus"}},
+		{3, map[string]int{"free_spins": 10, "bonus": 1}, []string{"free_spins", "bonus"}},
+		// The matching rule with the highest min_count applies.
+		{4, map[string]int{"free_spins": 15, "bonus": 1}, []string{"free_spins", "bonus"}},
+	}
+	for _, tt := range tests {
+		cfg := testConfig(t, scatterConfig)
+		features := cfg.evaluateTriggers(scatterGrid(tt.scatters))
+		var order []string
+		got := map[string]int{}
+		for _, f := range features {
+			order = append(order, f.Feature)
+			got[f.Feature] = f.Awarded
+			if len(f.Positions) != tt.scatters {
+				t.Errorf("%d scatters: %s lists %d positions", tt.scatters, f.Feature, len(f.Positions))
+			}
+		}
+		if len(tt.want) == 0 {
+			tt.want = map[string]int{}
+		}
+		if !reflect.DeepEqual(order, tt.order) || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%d scatters: triggered %v %v, want %v %v", tt.scatters, order, got, tt.order, tt.want)
+		}
+	}
+}
+
+func TestScatterSpin(t *testing.T) {
+	cfg := testConfig(t, scatterConfig)
+	// Scatters pay in total bets on top of the line wins, and wilds do not
+	// stand in for them.
+	cfg.ReelStrips = [][]string{{"S", "A", "A"}, {"W", "S", "A"}, {"S", "C", "C"}}
+	result, err := cfg.PerformSpin([]int64{0, 0, 0}, 2, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ScatterPositions) != 3 {
+		t.Errorf("got %d scatter positions, want 3", len(result.ScatterPositions))
+	}
+	if result.TotalWin != 10 {
+		t.Errorf("total win %d, want 10 for three scatters at a bet of 2", result.TotalWin)
+	}
+	if len(result.Features) != 2 || result.Features[0].Feature != FeatureFreeSpins || result.Features[0].Awarded != 10 {
+		t.Errorf("features %+v, want 10 free spins and the bonus", result.Features)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/internal/fsutil"
+)
+
+// FeatureSession is the persisted state of one feature in progress: free
+// spins or a hold-and-spin respins bonus.
+type FeatureSession struct {
+	ID            string `json:"id"`
+	Feature       string `json:"feature,omitempty"` // FeatureRespins, else free spins
+	GameCode      string `json:"game_code"`
+	ConfigVersion string `json:"config_version"`
+	PlayerID      string `json:"player_id,omitempty"`
+	RoundID       string `json:"round_id"` // the triggering round
+	BetAmount     int    `json:"bet_amount"`
+	SpinsAwarded  int    `json:"spins_awarded"`
+	SpinsPlayed   int    `json:"spins_played"`
+	Multiplier    int    `json:"multiplier"`
+	TotalWin      int    `json:"total_win"`
+	Completed     bool   `json:"completed"`
+	// Voided is set when the session was closed unfinished because the
+	// configuration it started on is no longer available.
+	Voided bool `json:"voided,omitempty"`
+	// LastSpin is the most recent free spin, returned again if the client
+	// retries it.
+	LastSpin *playedSpin `json:"last_spin,omitempty"`
+	// Sticky holds the sticky wilds of a free spins session.
+	Sticky []WildCell `json:"sticky,omitempty"`
+	// Respins holds the locked coins of a respins session.
+	Respins *RespinsState `json:"respins,omitempty"`
+	Updated time.Time     `json:"updated"`
+}
+
+// playedSpin is a resolved free spin as returned to the client.
+type playedSpin struct {
+	RoundID string     `json:"round_id"`
+	AuditID string     `json:"audit_id"`
+	Result  SpinResult `json:"result"`
+}
+
+// errNoSession is returned for sessions that do not exist.
+var errNoSession = errors.New("no such feature session")
+
+// sessionStore persists feature sessions as one JSON file each in dir,
+// replaced atomically on every update, so a feature in progress survives an
+// engine restart.
+type sessionStore struct {
+	dir string
+	// locks serialise work on one session or player; keys are hashed onto
+	// a fixed set of mutexes.
+	locks [64]sync.Mutex
+
+	mu     sync.Mutex
+	active map[string]string // player id -> session in progress
+}
+
+// openSessionStore opens dir, creating it if needed, and indexes the
+// features still in progress.
+func openSessionStore(dir string) (*sessionStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	st := &sessionStore{dir: dir, active: make(map[string]string)}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		sess, err := st.load(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if !sess.Completed && sess.PlayerID != "" {
+			st.active[sess.PlayerID] = sess.ID
+		}
+	}
+	log.Printf("Session store %s opened, %d features in progress", dir, len(st.active))
+	return st, nil
+}
+
+// lock serialises callers using the same key, e.g. a session id, and
+// returns the matching unlock.
+func (st *sessionStore) lock(key string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	m := &st.locks[h.Sum32()%uint32(len(st.locks))]
+	m.Lock()
+	return m.Unlock
+}
+
+// configPath returns where the configuration version pinned by sessions of
+// gameCode is kept. The version is hex encoded so it can safely name a file.
+func (st *sessionStore) configPath(gameCode, version string) string {
+	return filepath.Join(st.dir, "configs", gameCode+"-"+hex.EncodeToString([]byte(version))+".json")
+}
+
+// pinConfig keeps a copy of cfg for the sessions started on it, so they can
+// finish on the same maths after the game's configuration changes.
+func (st *sessionStore) pinConfig(cfg *GameConfig) error {
+	path := st.configPath(cfg.GameCode, cfg.Version)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return fsutil.WriteFileAtomic(path, data)
+}
+
+// pinnedConfig loads the configuration version a session started on.
+func (st *sessionStore) pinnedConfig(gameCode, version string) (*GameConfig, error) {
+	return LoadGameConfig(st.configPath(gameCode, version))
+}
+
+// validSessionID reports whether id has the form of a session id, so it can
+// safely name a file.
+func validSessionID(id string) bool {
+	b, err := hex.DecodeString(id)
+	return err == nil && len(b) == 16
+}
+
+func (st *sessionStore) path(id string) string {
+	return filepath.Join(st.dir, id+".json")
+}
+
+func (st *sessionStore) load(id string) (*FeatureSession, error) {
+	if !validSessionID(id) {
+		return nil, errNoSession
+	}
+	data, err := os.ReadFile(st.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errNoSession
+	}
+	if err != nil {
+		return nil, err
+	}
+	sess := &FeatureSession{}
+	if err := json.Unmarshal(data, sess); err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+// activeFor returns the id of the player's feature in progress, if any.
+func (st *sessionStore) activeFor(playerID string) (string, bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	id, ok := st.active[playerID]
+	return id, ok
+}
+
+// save writes sess durably, replacing any previous state, and updates the
+// index of features in progress.
+func (st *sessionStore) save(sess *FeatureSession) error {
+	sess.Updated = time.Now().UTC()
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return err
+	}
+	if err := fsutil.WriteFileAtomic(st.path(sess.ID), data); err != nil {
+		return err
+	}
+
+	if sess.PlayerID != "" {
+		st.mu.Lock()
+		if sess.Completed {
+			if st.active[sess.PlayerID] == sess.ID {
+				delete(st.active, sess.PlayerID)
+			}
+		} else {
+			st.active[sess.PlayerID] = sess.ID
+		}
+		st.mu.Unlock()
+	}
+	return nil
+}
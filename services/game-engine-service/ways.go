@@ -0,0 +1,110 @@
+package main
+
+import "sort"
+
+// evaluateWays pays every paytable symbol that lands on adjacent reels from
+// the leftmost, anywhere in each reel. The number of ways is the product of
+// the matching cells on each reel, wilds included. Wilds substitute only;
+// they do not pay ways of their own. Multiplier wilds multiply only the ways
+// passing through them, so their effect is folded into Payout rather than
+// Multiplier.
+func (cfg *GameConfig) evaluateWays(matrix [][]string, baseBet int) []WinLine {
+	var wins []WinLine
+	for _, symbol := range cfg.payingSymbols() {
+		ways := 1
+		var positions []Position
+		var counts []wayCells
+		for reel := 0; reel < cfg.Grid.Reels; reel++ {
+			var cells wayCells
+			for row := range matrix {
+				s := matrix[row][reel]
+				if s != symbol && !cfg.isWild(s) {
+					continue
+				}
+				positions = append(positions, Position{Reel: reel, Row: row})
+				if m := cfg.WildFeatures[s].Multiplier; m > 1 {
+					cells.wilds++
+					cells.sum += m
+				} else {
+					cells.plain++
+				}
+			}
+			if cells.plain+cells.wilds == 0 {
+				break
+			}
+			ways *= cells.plain + cells.wilds
+			counts = append(counts, cells)
+		}
+		reels := len(counts)
+		// A run made only of wilds belongs to no symbol.
+		if !containsSymbol(matrix, positions, symbol) {
+			continue
+		}
+		if pay := cfg.Paytable[symbol][reels]; pay > 0 {
+			wins = append(wins, WinLine{
+				Symbol:     symbol,
+				Count:      reels,
+				Positions:  positions,
+				Multiplier: 1,
+				Payout:     pay * cfg.multipliedWays(counts) * baseBet,
+				Ways:       ways,
+			})
+		}
+	}
+	return wins
+}
+
+// wayCells counts the matching cells on one reel of a ways win.
+type wayCells struct {
+	plain int // symbols and wilds without a multiplier
+	wilds int // multiplier wilds
+	sum   int // sum of the multiplier wilds' multipliers
+}
+
+// multipliedWays returns the ways through counts with each way weighted by
+// its multiplier wilds, combined by the game's rule; a way without any
+// counts once.
+func (cfg *GameConfig) multipliedWays(counts []wayCells) int {
+	if cfg.WildMultipliers == WildsMultiply {
+		// A multiplier wild counts m times on its reel, so the product
+		// multiplies the wilds along each way.
+		total := 1
+		for _, c := range counts {
+			total *= c.plain + c.sum
+		}
+		return total
+	}
+	// For added multipliers, track the ways without a multiplier wild, the
+	// ways with one or more, and the sum of the latter's multipliers.
+	plain, multiplied, sum := 1, 0, 0
+	for _, c := range counts {
+		n := c.plain + c.wilds
+		sum = sum*n + (plain+multiplied)*c.sum
+		multiplied = multiplied*n + plain*c.wilds
+		plain *= c.plain
+	}
+	return plain + sum
+}
+
+// containsSymbol reports whether any of positions holds symbol itself.
+func containsSymbol(matrix [][]string, positions []Position, symbol string) bool {
+	for _, p := range positions {
+		if matrix[p.Row][p.Reel] == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// payingSymbols returns the paytable symbols other than wilds, sorted so
+// wins come out in a stable order.
+func (cfg *GameConfig) payingSymbols() []string {
+	var symbols []string
+	for symbol := range cfg.Paytable {
+		if !cfg.isWild(symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
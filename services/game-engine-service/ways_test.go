@@ -0,0 +1,87 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+const waysConfig = `{
+	"game_code": "ways", "version": "1", "evaluation": "ways", "bet_multiplier": 2,
+	"grid": {"rows": 3, "reels": 3},
+	"paytable": {"A": {"2": 1, "3": 5}, "B": {"3": 2}},
+	"wilds": ["W"],
+	"reel_strips": [["A"], ["A"], ["A"]]
+}`
+
+// waysWin is the part of a ways win the tests check.
+type waysWin struct {
+	Symbol string
+	Count  int
+	Ways   int
+	Payout int
+}
+
+func TestEvaluateWays(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix [][]string
+		want   []waysWin
+	}{
+		{
+			name:   "ways multiply across reels",
+			matrix: [][]string{{"A", "A", "C"}, {"C", "A", "C"}, {"C", "C", "A"}},
+			want:   []waysWin{{"A", 3, 2, 20}},
+		},
+		{
+			name:   "stops at the first reel without a match",
+			matrix: [][]string{{"A", "W", "C"}, {"C", "C", "C"}, {"C", "C", "C"}},
+			want:   []waysWin{{"A", 2, 1, 2}},
+		},
+		{
+			name:   "wilds on the first reel",
+			matrix: [][]string{{"W", "A", "A"}, {"A", "C", "C"}, {"C", "C", "C"}},
+			want:   []waysWin{{"A", 3, 2, 20}},
+		},
+		{
+			name:   "gap on the second reel",
+			matrix: [][]string{{"A", "C", "A"}, {"C", "C", "C"}, {"C", "C", "C"}},
+		},
+		{
+			name:   "wilds alone pay nothing",
+			matrix: [][]string{{"W", "W", "W"}, {"C", "C", "C"}, {"C", "C", "C"}},
+		},
+		{
+			name:   "each symbol pays its own ways",
+			matrix: [][]string{{"A", "A", "A"}, {"B", "B", "B"}, {"C", "W", "C"}},
+			want:   []waysWin{{"A", 3, 2, 20}, {"B", 3, 2, 8}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, waysConfig)
+			var got []waysWin
+			for _, w := range cfg.evaluateWays(tt.matrix, 2) {
+				got = append(got, waysWin{w.Symbol, w.Count, w.Ways, w.Payout})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaysPositions(t *testing.T) {
+	cfg := testConfig(t, waysConfig)
+	matrix := [][]string{{"A", "W", "C"}, {"C", "A", "A"}, {"A", "C", "C"}}
+	wins := cfg.evaluateWays(matrix, 1)
+	if len(wins) != 1 {
+		t.Fatalf("got %d wins, want 1", len(wins))
+	}
+	want := []Position{{Reel: 0, Row: 0}, {Reel: 0, Row: 2}, {Reel: 1, Row: 0}, {Reel: 1, Row: 1}, {Reel: 2, Row: 1}}
+	if !reflect.DeepEqual(wins[0].Positions, want) {
+		t.Errorf("positions %+v, want %+v", wins[0].Positions, want)
+	}
+	if wins[0].Ways != 4 || wins[0].Payout != 20 {
+		t.Errorf("got %d ways paying %d, want 4 paying 20", wins[0].Ways, wins[0].Payout)
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Combination rules for GameConfig.WildMultipliers.
+const (
+	WildsAdd      = "add"      // the multipliers of a win's wilds add up (default)
+	WildsMultiply = "multiply" // the multipliers of a win's wilds multiply
+)
+
+// maxWildRespins bounds the walking wild respins in one round.
+const maxWildRespins = 50
+
+// WildFeature adds behaviours to one of the game's wilds. They apply to the
+// grid before it is evaluated.
+type WildFeature struct {
+	// Expanding wilds fill their whole reel when they land.
+	Expanding bool `json:"expanding"`
+	// Sticky wilds landing in free spins stay in place for the rest of the
+	// feature.
+	Sticky bool `json:"sticky"`
+	// Walking wilds award a respin in which they move one reel to the left
+	// and every other cell spins again, until they walk off the grid.
+	Walking bool `json:"walking"`
+	// Multiplier multiplies every win the wild is part of; 0 or 1 for none.
+	// Several in one win combine by GameConfig.WildMultipliers.
+	Multiplier int `json:"multiplier"`
+}
+
+// WildCell is a wild held on the grid, e.g. a sticky or walking wild.
+type WildCell struct {
+	Position Position `json:"position"`
+	Symbol   string   `json:"symbol"`
+}
+
+// WildRespin is a respin awarded by walking wilds.
+type WildRespin struct {
+	Matrix   [][]string `json:"matrix"` // rows x reels, after the wilds are placed
+	Stops    []int64    `json:"stops"`
+	WinLines []WinLine  `json:"win_lines"`
+	Win      int        `json:"win"`
+	// Walking lists the walking wilds moved onto this grid.
+	Walking  []WildCell `json:"walking"`
+	Expanded []int      `json:"expanded,omitempty"`
+	// PreRevealMatrix and Mystery record mystery symbols revealed on this
+	// grid, as for SpinResult.
+	PreRevealMatrix [][]string     `json:"pre_reveal_matrix,omitempty"`
+	Mystery         *MysteryReveal `json:"mystery,omitempty"`
+	// AuditID is the RNG draw behind the stops.
+	AuditID string `json:"audit_id"`
+}
+
+func (cfg *GameConfig) validateWilds() error {
+	switch cfg.WildMultipliers {
+	case "", WildsAdd, WildsMultiply:
+	default:
+		return fmt.Errorf("unknown wild_multipliers %q", cfg.WildMultipliers)
+	}
+	for symbol, f := range cfg.WildFeatures {
+		if !cfg.isWild(symbol) {
+			return fmt.Errorf("wild feature for %s, which is not a wild", symbol)
+		}
+		if f.Multiplier < 0 {
+			return fmt.Errorf("wild %s: negative multiplier", symbol)
+		}
+		if (f.Sticky || f.Walking) && len(cfg.Grid.Heights) > 0 {
+			return fmt.Errorf("wild %s: sticky and walking wilds need a fixed grid", symbol)
+		}
+		if f.Walking && cfg.Cascade != nil {
+			return errors.New("walking wilds cannot be combined with cascades")
+		}
+	}
+	return nil
+}
+
+// wildCells returns the cells of matrix holding a wild with a feature
+// matching want, in reel order.
+func (cfg *GameConfig) wildCells(matrix [][]string, want func(WildFeature) bool) []WildCell {
+	var cells []WildCell
+	for reel := range matrix[0] {
+		for row := range matrix {
+			symbol := matrix[row][reel]
+			if f, ok := cfg.WildFeatures[symbol]; ok && want(f) {
+				cells = append(cells, WildCell{Position: Position{Reel: reel, Row: row}, Symbol: symbol})
+			}
+		}
+	}
+	return cells
+}
+
+// stickyWilds returns the cells of matrix holding a sticky wild.
+func (cfg *GameConfig) stickyWilds(matrix [][]string) []WildCell {
+	return cfg.wildCells(matrix, func(f WildFeature) bool { return f.Sticky })
+}
+
+// placeWilds puts held wilds onto matrix, over whatever landed there.
+func placeWilds(matrix [][]string, cells []WildCell) {
+	for _, c := range cells {
+		matrix[c.Position.Row][c.Position.Reel] = c.Symbol
+	}
+}
+
+// expandWilds fills every reel holding an expanding wild with that wild and
+// returns the reels it filled.
+func (cfg *GameConfig) expandWilds(matrix [][]string) []int {
+	var reels []int
+	for reel := range matrix[0] {
+		wild := ""
+		for row := range matrix {
+			if f, ok := cfg.WildFeatures[matrix[row][reel]]; ok && f.Expanding {
+				wild = matrix[row][reel]
+				break
+			}
+		}
+		if wild == "" {
+			continue
+		}
+		for row := range matrix {
+			// Cells below a shorter reel stay empty.
+			if matrix[row][reel] != "" {
+				matrix[row][reel] = wild
+			}
+		}
+		reels = append(reels, reel)
+	}
+	return reels
+}
+
+// applyWildMultipliers multiplies each line or cluster win by the
+// multiplier wilds among its cells, combined by the game's rule, and returns
+// wins. Ways wins apply multiplier wilds per way as they are evaluated.
+func (cfg *GameConfig) applyWildMultipliers(matrix [][]string, wins []WinLine) []WinLine {
+	if len(cfg.WildFeatures) == 0 || cfg.Evaluation == EvalWays {
+		return wins
+	}
+	for i, w := range wins {
+		m := 0
+		for _, p := range w.Positions {
+			wm := cfg.WildFeatures[matrix[p.Row][p.Reel]].Multiplier
+			if wm <= 1 {
+				continue
+			}
+			switch {
+			case m == 0:
+				m = wm
+			case cfg.WildMultipliers == WildsMultiply:
+				m *= wm
+			default:
+				m += wm
+			}
+		}
+		if m > 1 {
+			wins[i].Multiplier *= m
+			wins[i].Payout *= m
+		}
+	}
+	return wins
+}
+
+// walk plays the respins awarded by walking wilds on matrix. Each respin
+// moves every walking wild one reel left, drops those leaving the grid and
+// spins the other cells again with stops drawn through draw; held sticky
+// wilds stay in place and walking wilds landing on a respin join in. Each
+// respin is played in mode, like the round that awarded it.
+func (cfg *GameConfig) walk(mode spinMode, heights []int, matrix [][]string, baseBet int, fill FillFunc, draw DrawFunc) ([]WildRespin, error) {
+	strips := mode.strips
+	walking := func(f WildFeature) bool { return f.Walking }
+	var respins []WildRespin
+	for len(respins) < maxWildRespins {
+		var moved []WildCell
+		for _, c := range cfg.wildCells(matrix, walking) {
+			if c.Position.Reel > 0 {
+				c.Position.Reel--
+				moved = append(moved, c)
+			}
+		}
+		if len(moved) == 0 {
+			break
+		}
+		if draw == nil {
+			return nil, errors.New("walking wilds: respin without an RNG")
+		}
+
+		// Walking wilds need a fixed grid, so only the stops are drawn,
+		// each bounded by its strip as for the base spin.
+		stops, auditID, err := draw(cfg.reelBounds(strips)[:len(strips)])
+		if err != nil {
+			return nil, err
+		}
+		if len(stops) != len(strips) {
+			return nil, fmt.Errorf("walking wilds: got %d stops for %d reels", len(stops), len(strips))
+		}
+		if matrix, err = cfg.window(strips, stops, heights); err != nil {
+			return nil, err
+		}
+		placeWilds(matrix, mode.held)
+		placeWilds(matrix, moved)
+		rs := WildRespin{Matrix: matrix, Stops: stops, Walking: moved, AuditID: auditID}
+		pre := copyMatrix(matrix)
+		if rs.Mystery, err = cfg.reveal(matrix, mode.mystery, fill); err != nil {
+			return nil, err
+		}
+		if rs.Mystery != nil {
+			rs.PreRevealMatrix = pre
+		}
+		rs.Expanded = cfg.expandWilds(matrix)
+		rs.WinLines = applyMultiplier(cfg.evaluateWins(matrix, baseBet), mode.multiplier)
+		for _, w := range rs.WinLines {
+			rs.Win += w.Payout
+		}
+		respins = append(respins, rs)
+	}
+	return respins, nil
+}
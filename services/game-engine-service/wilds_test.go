@@ -0,0 +1,270 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// stopSequence returns a DrawFunc answering each call with the next of
+// draws, for tests.
+func stopSequence(t *testing.T, draws ...[]int64) DrawFunc {
+	return func(bounds []int64) ([]int64, string, error) {
+		if len(draws) == 0 {
+			t.Fatalf("unexpected draw for %d bounds", len(bounds))
+		}
+		next := draws[0]
+		draws = draws[1:]
+		return next, "audit", nil
+	}
+}
+
+const waysWildsConfig = `{
+	"game_code": "ways_wilds", "version": "1", "evaluation": "ways", "bet_multiplier": 1,
+	"grid": {"rows": 3, "reels": 3},
+	"paytable": {"A": {"3": 1}},
+	"wilds": ["W", "X", "E"],
+	"wild_features": {"W": {"multiplier": 2}, "X": {"multiplier": 3}, "E": {"expanding": true, "multiplier": 2}},
+	"reel_strips": [["A"], ["A"], ["A"]]
+}`
+
+func TestWaysWildMultipliers(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   string
+		matrix [][]string
+		ways   int
+		payout int
+	}{
+		{
+			// Only the way through W is doubled: 2x + 1x.
+			name:   "one wild",
+			matrix: [][]string{{"A", "W", "A"}, {"C", "A", "C"}, {"C", "B", "C"}},
+			ways:   2, payout: 3,
+		},
+		{
+			name:   "one wild multiplied",
+			mode:   WildsMultiply,
+			matrix: [][]string{{"A", "W", "A"}, {"C", "A", "C"}, {"C", "B", "C"}},
+			ways:   2, payout: 3,
+		},
+		{
+			// A column of x2 wilds doubles each of its three ways.
+			name:   "full reel",
+			matrix: [][]string{{"A", "W", "A"}, {"C", "W", "C"}, {"C", "W", "C"}},
+			ways:   3, payout: 6,
+		},
+		{
+			name:   "full reel multiplied",
+			mode:   WildsMultiply,
+			matrix: [][]string{{"A", "W", "A"}, {"C", "W", "C"}, {"C", "W", "C"}},
+			ways:   3, payout: 6,
+		},
+		{
+			// Ways: A-W-X (2+3), A-W-A (2), A-A-X (3), A-A-A (1).
+			name:   "two reels added",
+			matrix: [][]string{{"A", "W", "X"}, {"C", "A", "A"}, {"C", "C", "C"}},
+			ways:   4, payout: 11,
+		},
+		{
+			// Ways: A-W-X (2*3), A-W-A (2), A-A-X (3), A-A-A (1).
+			name:   "two reels multiplied",
+			mode:   WildsMultiply,
+			matrix: [][]string{{"A", "W", "X"}, {"C", "A", "A"}, {"C", "C", "C"}},
+			ways:   4, payout: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, waysWildsConfig)
+			cfg.WildMultipliers = tt.mode
+			wins := cfg.evaluateWins(tt.matrix, 1)
+			if len(wins) != 1 {
+				t.Fatalf("got %d wins, want 1: %+v", len(wins), wins)
+			}
+			if wins[0].Ways != tt.ways || wins[0].Payout != tt.payout {
+				t.Errorf("got %d ways paying %d, want %d paying %d", wins[0].Ways, wins[0].Payout, tt.ways, tt.payout)
+			}
+		})
+	}
+}
+
+func TestWaysExpandingMultiplierWild(t *testing.T) {
+	for _, mode := range []string{WildsAdd, WildsMultiply} {
+		t.Run(mode, func(t *testing.T) {
+			cfg := testConfig(t, waysWildsConfig)
+			cfg.WildMultipliers = mode
+			cfg.ReelStrips = [][]string{{"A", "C", "C"}, {"C", "E", "C"}, {"A", "C", "C"}}
+			result, err := cfg.PerformSpin([]int64{0, 0, 0}, 1, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(result.Expanded, []int{1}) {
+				t.Errorf("expanded reels %v, want [1]", result.Expanded)
+			}
+			// Three ways, each through one x2 wild.
+			if result.TotalWin != 6 {
+				t.Errorf("total win %d, want 6", result.TotalWin)
+			}
+		})
+	}
+}
+
+func TestLineWildMultipliers(t *testing.T) {
+	const raw = `{
+		"game_code": "line_wilds", "version": "1",
+		"grid": {"rows": 1, "reels": 3},
+		"paylines": [[0, 0, 0]],
+		"paytable": {"A": {"3": 10}},
+		"wilds": ["W", "X"],
+		"wild_features": {"W": {"multiplier": 2}, "X": {"multiplier": 3}},
+		"reel_strips": [["A"], ["A"], ["A"]]
+	}`
+	tests := []struct {
+		name       string
+		mode       string
+		line       []string
+		multiplier int
+	}{
+		{"none", "", []string{"A", "A", "A"}, 1},
+		{"one", "", []string{"A", "W", "A"}, 2},
+		{"added", WildsAdd, []string{"A", "W", "X"}, 5},
+		{"multiplied", WildsMultiply, []string{"A", "W", "X"}, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, raw)
+			cfg.WildMultipliers = tt.mode
+			wins := cfg.evaluateWins([][]string{tt.line}, 1)
+			if len(wins) != 1 {
+				t.Fatalf("got %d wins, want 1", len(wins))
+			}
+			if wins[0].Multiplier != tt.multiplier || wins[0].Payout != 10*tt.multiplier {
+				t.Errorf("got x%d paying %d, want x%d", wins[0].Multiplier, wins[0].Payout, tt.multiplier)
+			}
+		})
+	}
+}
+
+const lineWildsConfig = `{
+	"game_code": "wild_features", "version": "1",
+	"grid": {"rows": 3, "reels": 3},
+	"paylines": [[0, 0, 0], [1, 1, 1], [2, 2, 2]],
+	"paytable": {"A": {"3": 10}, "B": {"3": 5}},
+	"wilds": ["S", "K"],
+	"wild_features": {"S": {"sticky": true}, "K": {"walking": true}},
+	"reel_strips": [["A", "B", "C", "D"], ["C", "C", "S", "C"], ["A", "B", "C", "K"]]
+}`
+
+func TestStickyWilds(t *testing.T) {
+	cfg := testConfig(t, lineWildsConfig)
+	first, err := cfg.PerformFreeSpin([]int64{0, 2, 0}, 3, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []WildCell{{Position: Position{Reel: 1, Row: 0}, Symbol: "S"}}
+	if !reflect.DeepEqual(first.Sticky, want) {
+		t.Fatalf("sticky after first spin %+v, want %+v", first.Sticky, want)
+	}
+
+	// The wild stays in place while reel 1 lands elsewhere.
+	second, err := cfg.PerformFreeSpin([]int64{0, 3, 0}, 3, first.Sticky, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := second.Matrix[0][1]; got != "S" {
+		t.Errorf("held cell shows %s, want S", got)
+	}
+	if !reflect.DeepEqual(second.Sticky, want) {
+		t.Errorf("sticky after second spin %+v, want %+v", second.Sticky, want)
+	}
+	if second.TotalWin != 10 {
+		t.Errorf("total win %d, want 10 for A-S-A", second.TotalWin)
+	}
+
+	// Base spins do not hold sticky wilds.
+	base, err := cfg.PerformSpin([]int64{0, 2, 0}, 3, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if base.Sticky != nil {
+		t.Errorf("base spin sticky %+v, want none", base.Sticky)
+	}
+}
+
+func TestWalkingWilds(t *testing.T) {
+	cfg := testConfig(t, lineWildsConfig)
+	// K lands on reel 2, row 0, then walks to reel 1 and reel 0.
+	draw := stopSequence(t, []int64{0, 0, 0}, []int64{1, 0, 0})
+	result, err := cfg.PerformSpin([]int64{1, 0, 3}, 3, nil, draw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.WildRespins) != 2 {
+		t.Fatalf("got %d respins, want 2", len(result.WildRespins))
+	}
+	first, second := result.WildRespins[0], result.WildRespins[1]
+	if got := first.Matrix[0][1]; got != "K" {
+		t.Errorf("first respin reel 1 shows %s, want K", got)
+	}
+	if got := second.Matrix[0][0]; got != "K" {
+		t.Errorf("second respin reel 0 shows %s, want K", got)
+	}
+	// Only the first respin pays, A-K-A on row 0.
+	if first.Win != 10 || second.Win != 0 || result.TotalWin != 10 {
+		t.Errorf("respins won %d and %d, total %d; want 10, 0 and 10", first.Win, second.Win, result.TotalWin)
+	}
+
+	if _, err := cfg.PerformSpin([]int64{1, 0, 3}, 3, nil, nil); err == nil {
+		t.Error("walking wild without an RNG did not fail")
+	}
+}
+
+func TestStickyWalkingWilds(t *testing.T) {
+	cfg := testConfig(t, lineWildsConfig)
+	// In a free spin S lands on reel 1, row 2 and sticks while K walks
+	// from reel 2 to reel 0 over two respins.
+	draw := stopSequence(t, []int64{0, 3, 0}, []int64{1, 3, 0})
+	result, err := cfg.PerformFreeSpin([]int64{1, 0, 3}, 3, nil, nil, draw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.WildRespins) != 2 {
+		t.Fatalf("got %d respins, want 2", len(result.WildRespins))
+	}
+	for i, rs := range result.WildRespins {
+		if got := rs.Matrix[2][1]; got != "S" {
+			t.Errorf("respin %d: held cell shows %s, want S", i+1, got)
+		}
+		if got := rs.Matrix[0][1-i]; got != "K" {
+			t.Errorf("respin %d: reel %d shows %s, want K", i+1, 1-i, got)
+		}
+	}
+	want := []WildCell{{Position: Position{Reel: 1, Row: 2}, Symbol: "S"}}
+	if !reflect.DeepEqual(result.Sticky, want) {
+		t.Errorf("sticky %+v, want %+v", result.Sticky, want)
+	}
+	if result.TotalWin != 10 {
+		t.Errorf("total win %d, want 10 for A-K-A", result.TotalWin)
+	}
+}
+
+func TestValidateWilds(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*GameConfig)
+	}{
+		{"not a wild", func(cfg *GameConfig) { cfg.WildFeatures["A"] = WildFeature{Expanding: true} }},
+		{"negative multiplier", func(cfg *GameConfig) { cfg.WildFeatures["S"] = WildFeature{Multiplier: -1} }},
+		{"unknown combination", func(cfg *GameConfig) { cfg.WildMultipliers = "max" }},
+		{"walking with cascades", func(cfg *GameConfig) { cfg.Cascade = &CascadeConfig{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, lineWildsConfig)
+			tt.modify(cfg)
+			if err := cfg.validate(); err == nil {
+				t.Error("validate accepted the config")
+			}
+		})
+	}
+}
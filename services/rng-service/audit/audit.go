@@ -0,0 +1,259 @@
+// Package audit keeps the tamper-evident draw log of the RNG service.
+//
+// The log is a file of JSON records, one per line. Each record carries a
+// sequence number, the hash of the previous record and its own hash, computed
+// as the hex SHA-256 of the record's JSON encoding with the hash field empty.
+// Removing, reordering or editing any record breaks the chain, which Verify
+// detects.
+//
+// The chain cannot show that records were cut from the end of the log: a
+// truncated log is a valid shorter chain. The head hash (Log.Head) must be
+// kept outside the log, e.g. from the service's startup line or a previous
+// verification, and checked with VerifyAnchor.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GenesisHash is the previous hash of the first record in a log.
+var GenesisHash = strings.Repeat("0", 64)
+
+// ErrFailed is wrapped by Append once a write to the log has failed. The log
+// accepts no further records until it is reopened.
+var ErrFailed = errors.New("audit: log failed")
+
+// Record is one audited draw.
+type Record struct {
+	Seq         uint64    `json:"seq"`
+	Time        time.Time `json:"time"`
+	Kind        string    `json:"kind"`
+	RequestID   string    `json:"request_id"`
+	AuditID     string    `json:"audit_id"`
+	Caller      string    `json:"caller"`
+	RoundID     string    `json:"round_id"`
+	Bounds      []int64   `json:"bounds"`
+	Outputs     []int64   `json:"outputs"`
+	Weights     [][]int64 `json:"weights,omitempty"`
+	Stream      string    `json:"stream,omitempty"`
+	DRBGCounter uint64    `json:"drbg_counter"`
+	// Reveal is set on records of kind "fair_reveal".
+	Reveal   *FairReveal `json:"reveal,omitempty"`
+	PrevHash string      `json:"prev_hash"`
+	Hash     string      `json:"hash,omitempty"`
+}
+
+// FairReveal is the server seed of a provably fair session the service
+// closed on its own, e.g. on expiry, published so the session's draws can
+// still be verified.
+type FairReveal struct {
+	ServerSeed     string `json:"server_seed"` // hex
+	ServerSeedHash string `json:"server_seed_hash"`
+	ClientSeed     string `json:"client_seed"`
+	NoncesUsed     uint64 `json:"nonces_used"`
+}
+
+// computeHash returns the hash of r with its Hash field ignored.
+func computeHash(r Record) (string, error) {
+	r.Hash = ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Log appends records to a hash-chained log file. It is safe for concurrent use.
+type Log struct {
+	mu       sync.Mutex
+	f        *os.File
+	nextSeq  uint64
+	lastHash string
+	size     int64 // end of the last record written
+	err      error // first write failure; set, the log is closed for appends
+}
+
+// Open opens or creates the log at path. An existing log is verified first,
+// and Open fails if its chain is broken.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := Verify(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("audit: existing log %s: %w", path, err)
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &Log{f: f, nextSeq: sum.Records, lastHash: sum.HeadHash, size: size}, nil
+}
+
+// Head returns the number of records in the log and the hash of the last.
+func (l *Log) Head() (uint64, string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.nextSeq, l.lastHash
+}
+
+// Append chains r onto the log and writes it durably. Seq, PrevHash and Hash
+// are filled in (and Time if zero); the completed record is returned.
+//
+// If the record cannot be written and synced, the log is cut back to the
+// previous record and fails every later Append with ErrFailed: after a
+// failed sync the file may not hold what was written, and a record left
+// half-written would break the chain for every record after it.
+func (l *Log) Append(r Record) (Record, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.err != nil {
+		return r, l.err
+	}
+
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+	r.Seq = l.nextSeq
+	r.PrevHash = l.lastHash
+	hash, err := computeHash(r)
+	if err != nil {
+		return r, err
+	}
+	r.Hash = hash
+
+	line, err := json.Marshal(r)
+	if err != nil {
+		return r, err
+	}
+	line = append(line, '\n')
+	if _, err := l.f.Write(line); err != nil {
+		return r, l.fail(err)
+	}
+	if err := l.f.Sync(); err != nil {
+		return r, l.fail(err)
+	}
+	l.nextSeq++
+	l.lastHash = hash
+	l.size += int64(len(line))
+	return r, nil
+}
+
+// fail marks the log failed after err and truncates any partial record.
+// Callers must hold l.mu.
+func (l *Log) fail(err error) error {
+	l.err = fmt.Errorf("%w: %v", ErrFailed, err)
+	if terr := l.f.Truncate(l.size); terr != nil {
+		l.err = fmt.Errorf("%w: %v; truncating to %d bytes: %v", ErrFailed, err, l.size, terr)
+	} else {
+		l.f.Sync()
+	}
+	return l.err
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// Summary describes a verified log.
+type Summary struct {
+	Records  uint64
+	HeadHash string
+}
+
+// ErrBrokenChain is wrapped by every integrity failure Verify reports.
+var ErrBrokenChain = errors.New("audit: broken chain")
+
+// ErrTruncated is reported by VerifyAnchor for a log that no longer holds
+// its anchor.
+var ErrTruncated = errors.New("audit: log truncated")
+
+// Verify reads a log from r and checks sequence numbers (gaps and
+// reordering), previous-hash links (removed or inserted records) and record
+// hashes (edits). It cannot detect records removed from the end; see
+// VerifyAnchor.
+func Verify(r io.Reader) (Summary, error) {
+	return verify(r, nil)
+}
+
+// VerifyAnchor verifies the log like Verify and also checks that its first
+// records records end in head, as noted from Log.Head or an earlier Summary.
+// A log cut back to fewer records fails with ErrTruncated.
+func VerifyAnchor(r io.Reader, records uint64, head string) (Summary, error) {
+	found := records == 0 && head == GenesisHash
+	sum, err := verify(r, func(rec Record) error {
+		if rec.Seq+1 != records {
+			return nil
+		}
+		if rec.Hash != head {
+			return fmt.Errorf("%w: seq %d: hash does not match anchor", ErrBrokenChain, rec.Seq)
+		}
+		found = true
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+	if !found {
+		return sum, fmt.Errorf("%w: %d records, anchor is at %d", ErrTruncated, sum.Records, records)
+	}
+	return sum, nil
+}
+
+// verify checks the chain of the log in r, calling check on every valid
+// record if it is not nil.
+func verify(r io.Reader, check func(Record) error) (Summary, error) {
+	sum := Summary{HeadHash: GenesisHash}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		// Unknown fields would be dropped from the recomputed hash, so a
+		// verifier older than the log reports them instead of a mismatch.
+		var rec Record
+		dec := json.NewDecoder(bytes.NewReader(sc.Bytes()))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			return sum, fmt.Errorf("%w: line %d: %v", ErrBrokenChain, line, err)
+		}
+		if rec.Seq != sum.Records {
+			return sum, fmt.Errorf("%w: line %d: sequence %d, expected %d", ErrBrokenChain, line, rec.Seq, sum.Records)
+		}
+		if rec.PrevHash != sum.HeadHash {
+			return sum, fmt.Errorf("%w: line %d (seq %d): previous hash does not match", ErrBrokenChain, line, rec.Seq)
+		}
+		want, err := computeHash(rec)
+		if err != nil {
+			return sum, err
+		}
+		if rec.Hash != want {
+			return sum, fmt.Errorf("%w: line %d (seq %d): record hash does not match contents", ErrBrokenChain, line, rec.Seq)
+		}
+		if check != nil {
+			if err := check(rec); err != nil {
+				return sum, err
+			}
+		}
+		sum.Records++
+		sum.HeadHash = rec.Hash
+	}
+	return sum, sc.Err()
+}
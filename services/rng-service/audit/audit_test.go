@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyAnchorTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := l.Append(Record{Kind: "numbers", Outputs: []int64{int64(i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records, head := l.Head()
+	l.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyAnchor(bytes.NewReader(data), records, head); err != nil {
+		t.Fatalf("anchored log: %v", err)
+	}
+
+	// Dropping the last record leaves a valid chain that no longer holds
+	// the anchor.
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	cut := bytes.Join(lines[:2], nil)
+	if _, err := Verify(bytes.NewReader(cut)); err != nil {
+		t.Fatalf("truncated chain: %v", err)
+	}
+	if _, err := VerifyAnchor(bytes.NewReader(cut), records, head); !errors.Is(err, ErrTruncated) {
+		t.Errorf("truncated log: %v, want ErrTruncated", err)
+	}
+	if _, err := VerifyAnchor(bytes.NewReader(data), records-1, head); !errors.Is(err, ErrBrokenChain) {
+		t.Errorf("wrong anchor: %v, want ErrBrokenChain", err)
+	}
+}
+
+func TestAppendFailed(t *testing.T) {
+	l, err := Open(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Append(Record{Kind: "numbers"}); err != nil {
+		t.Fatal(err)
+	}
+	l.f.Close()
+	if _, err := l.Append(Record{Kind: "numbers"}); !errors.Is(err, ErrFailed) {
+		t.Fatalf("append to closed file: %v, want ErrFailed", err)
+	}
+
+	// The log stays failed even once the file is writable again.
+	f, err := os.OpenFile(l.f.Name(), os.O_RDWR|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.f = f
+	if _, err := l.Append(Record{Kind: "numbers"}); !errors.Is(err, ErrFailed) {
+		t.Errorf("append after failure: %v, want ErrFailed", err)
+	}
+	if records, _ := l.Head(); records != 1 {
+		t.Errorf("head at %d records, want 1", records)
+	}
+	l.Close()
+}
@@ -0,0 +1,74 @@
+// Command fairverify recomputes and checks a provably fair draw once its
+// server seed has been revealed.
+//
+//	fairverify -server-seed <hex> -commitment <hex> -client-seed lucky7 \
+//	    -nonce 3 -bounds 32,32,32,32,32 -numbers 4,17,0,29,8
+//
+// Without -numbers it prints the derived values instead of checking them.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/fair"
+)
+
+func main() {
+	serverSeed := flag.String("server-seed", "", "revealed server seed (hex)")
+	commitment := flag.String("commitment", "", "server seed hash published before the draw (hex)")
+	clientSeed := flag.String("client-seed", "", "client seed")
+	nonce := flag.Uint64("nonce", 0, "draw nonce")
+	bounds := flag.String("bounds", "", "comma-separated exclusive upper bounds")
+	numbers := flag.String("numbers", "", "comma-separated numbers to verify")
+	flag.Parse()
+
+	seed, err := hex.DecodeString(*serverSeed)
+	if err != nil {
+		log.Fatalf("invalid server seed: %v", err)
+	}
+	b, err := parseList(*bounds)
+	if err != nil {
+		log.Fatalf("invalid bounds: %v", err)
+	}
+
+	if *numbers == "" {
+		got, err := fair.Draw(seed, *clientSeed, *nonce, b)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("commitment:", fair.Commit(seed))
+		fmt.Println("numbers:   ", got)
+		return
+	}
+
+	n, err := parseList(*numbers)
+	if err != nil {
+		log.Fatalf("invalid numbers: %v", err)
+	}
+	round := fair.Round{Nonce: *nonce, Bounds: b, Numbers: n}
+	if err := fair.Verify(seed, *commitment, *clientSeed, []fair.Round{round}); err != nil {
+		log.Fatalf("FAILED: %v", err)
+	}
+	fmt.Println("OK: draw matches the revealed server seed and commitment")
+}
+
+func parseList(s string) ([]int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	out := make([]int64, len(parts))
+	for i, p := range parts {
+		v, err := strconv.ParseInt(strings.TrimSpace(p), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Result is the outcome of one statistical test.
+type Result struct {
+	Name      string  `json:"name"`
+	Statistic float64 `json:"statistic"`
+	PValue    float64 `json:"p_value"`
+	Pass      bool    `json:"pass"`
+	Detail    string  `json:"detail"`
+}
+
+// Report is the full battery outcome.
+type Report struct {
+	Generator string `json:"generator"`
+	Samples   int    `json:"samples"`
+	Bound     int64  `json:"bound"`
+	// Alpha is the family-wise significance level: the chance that an ideal
+	// generator fails the battery as a whole.
+	Alpha float64 `json:"alpha"`
+	// TestAlpha is the Šidák-corrected level each test is held to, so that
+	// the battery's overall false-failure rate is Alpha.
+	TestAlpha float64   `json:"test_alpha"`
+	Started   time.Time `json:"started"`
+	Duration  string    `json:"duration"`
+	Pass      bool      `json:"pass"`
+	Results   []Result  `json:"results"`
+}
+
+const (
+	// Birthday spacings: m birthdays in a year of 2^24 days gives
+	// lambda = m^3 / (4 * 2^24) = 2 duplicate spacings per repetition.
+	birthdayDays = 1 << 24
+	birthdayM    = 512
+	birthdayReps = 1000
+
+	// NIST SP 800-22 block frequency test block length, in bits.
+	blockBits = 128
+
+	// pokerHand is the number of values per poker hand.
+	pokerHand = 5
+)
+
+// sidak returns the per-test significance level giving a family-wise
+// level of alpha over k independent tests: 1 - (1-alpha)^(1/k).
+func sidak(alpha float64, k int) float64 {
+	return -math.Expm1(math.Log1p(-alpha) / float64(k))
+}
+
+// runBattery draws the samples and runs every test. alpha is the
+// family-wise level; each test is held to its Šidák correction.
+func runBattery(s sampler, n int, bound int64, alpha float64) (*Report, error) {
+	rep := &Report{Generator: s.Name(), Samples: n, Bound: bound, Alpha: alpha, Started: time.Now().UTC()}
+
+	values, err := s.Draw(bound, n)
+	if err != nil {
+		return nil, fmt.Errorf("drawing values: %w", err)
+	}
+	bytes, err := s.Draw(256, n)
+	if err != nil {
+		return nil, fmt.Errorf("drawing bytes: %w", err)
+	}
+	bits := toBits(bytes)
+
+	birthdays := make([][]int64, birthdayReps)
+	for i := range birthdays {
+		if birthdays[i], err = s.Draw(birthdayDays, birthdayM); err != nil {
+			return nil, fmt.Errorf("drawing birthdays: %w", err)
+		}
+	}
+
+	rep.Results = []Result{
+		chiSquareTest(values, bound),
+		runsTest(values, bound),
+		serialCorrelationTest(values),
+		gapTest(values, bound),
+		pokerTest(values, bound),
+		birthdaySpacingsTest(birthdays),
+		monobitTest(bits),
+		blockFrequencyTest(bits),
+	}
+
+	rep.TestAlpha = sidak(alpha, len(rep.Results))
+	rep.Pass = true
+	for i := range rep.Results {
+		r := &rep.Results[i]
+		r.Pass = !math.IsNaN(r.PValue) && r.PValue >= rep.TestAlpha
+		rep.Pass = rep.Pass && r.Pass
+	}
+	rep.Duration = time.Since(rep.Started).Round(time.Millisecond).String()
+	return rep, nil
+}
+
+// chiSquareTest checks that every value in [0, bound) is equally frequent.
+func chiSquareTest(values []int64, bound int64) Result {
+	counts := make([]float64, bound)
+	for _, v := range values {
+		counts[v]++
+	}
+	expected := make([]float64, bound)
+	for i := range expected {
+		expected[i] = float64(len(values)) / float64(bound)
+	}
+	x2 := chiSquare(counts, expected)
+	df := float64(bound - 1)
+	return Result{
+		Name:      "chi-square",
+		Statistic: x2,
+		PValue:    igamc(df/2, x2/2),
+		Detail:    fmt.Sprintf("%d categories, %d degrees of freedom", bound, bound-1),
+	}
+}
+
+// runsTest is the Wald-Wolfowitz runs test above and below the median.
+// For odd bounds the median value itself is skipped.
+func runsTest(values []int64, bound int64) Result {
+	var n1, n2, runs float64
+	last := -1
+	for _, v := range values {
+		var side int
+		switch {
+		case 2*v+1 < bound:
+			side = 0
+		case bound%2 == 1 && 2*v+1 == bound:
+			continue
+		default:
+			side = 1
+		}
+		if side == 0 {
+			n1++
+		} else {
+			n2++
+		}
+		if side != last {
+			runs++
+			last = side
+		}
+	}
+	n := n1 + n2
+	mu := 2*n1*n2/n + 1
+	sigma := math.Sqrt((mu - 1) * (mu - 2) / (n - 1))
+	z := (runs - mu) / sigma
+	return Result{
+		Name:      "runs",
+		Statistic: z,
+		PValue:    math.Erfc(math.Abs(z) / math.Sqrt2),
+		Detail:    fmt.Sprintf("%.0f runs, expected %.1f", runs, mu),
+	}
+}
+
+// serialCorrelationTest checks the lag-1 serial correlation coefficient
+// against its null distribution (Knuth, TAOCP vol. 2, 3.3.2 K).
+func serialCorrelationTest(values []int64) Result {
+	n := float64(len(values))
+	var mean float64
+	for _, v := range values {
+		mean += float64(v)
+	}
+	mean /= n
+
+	var num, den float64
+	for i, v := range values {
+		x := float64(v) - mean
+		y := float64(values[(i+1)%len(values)]) - mean
+		num += x * y
+		den += x * x
+	}
+	c := num / den
+	mu := -1 / (n - 1)
+	sigma := math.Sqrt(n*(n-3)/(n+1)) / (n - 1)
+	z := (c - mu) / sigma
+	return Result{
+		Name:      "serial correlation",
+		Statistic: c,
+		PValue:    math.Erfc(math.Abs(z) / math.Sqrt2),
+		Detail:    fmt.Sprintf("lag-1 coefficient, z = %.3f", z),
+	}
+}
+
+// gapTest measures the gaps between values falling in the lower half of the
+// range (Knuth, TAOCP vol. 2, 3.3.2 D).
+func gapTest(values []int64, bound int64) Result {
+	half := bound / 2
+	p := float64(half) / float64(bound)
+
+	var gaps []int
+	gap := -1
+	for _, v := range values {
+		if gap >= 0 {
+			gap++
+		}
+		if v < half {
+			if gap >= 0 {
+				gaps = append(gaps, gap-1)
+			}
+			gap = 0
+		}
+	}
+
+	g := float64(len(gaps))
+	// Longest gap category t such that the tail still expects 5 or more.
+	t := 1
+	for t < 64 && g*math.Pow(1-p, float64(t+1)) >= 5 {
+		t++
+	}
+	counts := make([]float64, t+1)
+	for _, r := range gaps {
+		if r >= t {
+			counts[t]++
+		} else {
+			counts[r]++
+		}
+	}
+	expected := make([]float64, t+1)
+	for r := 0; r < t; r++ {
+		expected[r] = g * p * math.Pow(1-p, float64(r))
+	}
+	expected[t] = g * math.Pow(1-p, float64(t))
+
+	x2 := chiSquare(counts, expected)
+	return Result{
+		Name:      "gap",
+		Statistic: x2,
+		PValue:    igamc(float64(t)/2, x2/2),
+		Detail:    fmt.Sprintf("%d gaps for [0, %d), lengths 0..%d and >=%d", len(gaps), half, t-1, t),
+	}
+}
+
+// pokerTest counts the distinct values in hands of five (Knuth, TAOCP
+// vol. 2, 3.3.2 C), merging rare categories so each expects 5 or more.
+func pokerTest(values []int64, bound int64) Result {
+	hands := len(values) / pokerHand
+	counts := make([]float64, pokerHand+1)
+	seen := make(map[int64]bool, pokerHand)
+	for h := 0; h < hands; h++ {
+		clear(seen)
+		for _, v := range values[h*pokerHand : (h+1)*pokerHand] {
+			seen[v] = true
+		}
+		counts[len(seen)]++
+	}
+
+	// P(r distinct) = d(d-1)...(d-r+1) / d^5 * S(5, r).
+	stirling := []float64{0, 1, 15, 25, 10, 1}
+	d := float64(bound)
+	expected := make([]float64, pokerHand+1)
+	for r := 1; r <= pokerHand; r++ {
+		p := stirling[r]
+		for i := 0; i < r; i++ {
+			p *= (d - float64(i)) / d
+		}
+		p /= math.Pow(d, pokerHand-float64(r))
+		expected[r] = float64(hands) * p
+	}
+
+	var obs, exp []float64
+	var accO, accE float64
+	for r := 1; r <= pokerHand; r++ {
+		accO += counts[r]
+		accE += expected[r]
+		if accE >= 5 {
+			obs, exp = append(obs, accO), append(exp, accE)
+			accO, accE = 0, 0
+		}
+	}
+	if accE > 0 && len(exp) > 0 {
+		obs[len(obs)-1] += accO
+		exp[len(exp)-1] += accE
+	}
+
+	x2 := chiSquare(obs, exp)
+	df := float64(len(exp) - 1)
+	return Result{
+		Name:      "poker",
+		Statistic: x2,
+		PValue:    igamc(df/2, x2/2),
+		Detail:    fmt.Sprintf("%d hands of %d, %d categories", hands, pokerHand, len(exp)),
+	}
+}
+
+// birthdaySpacingsTest is Marsaglia's birthday spacings test: the number of
+// repeated spacings between sorted birthdays is asymptotically Poisson.
+func birthdaySpacingsTest(reps [][]int64) Result {
+	lambda := math.Pow(birthdayM, 3) / (4 * birthdayDays)
+	const maxJ = 6 // categories 0..5 and >=6
+
+	counts := make([]float64, maxJ+1)
+	for _, days := range reps {
+		sorted := append([]int64(nil), days...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		spacings := make([]int64, len(sorted)-1)
+		for i := range spacings {
+			spacings[i] = sorted[i+1] - sorted[i]
+		}
+		sort.Slice(spacings, func(i, j int) bool { return spacings[i] < spacings[j] })
+		j := 0
+		for i := 1; i < len(spacings); i++ {
+			if spacings[i] == spacings[i-1] {
+				j++
+			}
+		}
+		if j > maxJ {
+			j = maxJ
+		}
+		counts[j]++
+	}
+
+	expected := make([]float64, maxJ+1)
+	rest := 1.0
+	for j := 0; j < maxJ; j++ {
+		p := math.Exp(-lambda) * math.Pow(lambda, float64(j)) / math.Gamma(float64(j)+1)
+		expected[j] = float64(len(reps)) * p
+		rest -= p
+	}
+	expected[maxJ] = float64(len(reps)) * rest
+
+	x2 := chiSquare(counts, expected)
+	return Result{
+		Name:      "birthday spacings",
+		Statistic: x2,
+		PValue:    igamc(float64(maxJ)/2, x2/2),
+		Detail:    fmt.Sprintf("%d repetitions of %d birthdays in 2^24 days, lambda = %.2f", len(reps), birthdayM, lambda),
+	}
+}
+
+// monobitTest is the NIST SP 800-22 frequency (monobit) test.
+func monobitTest(bits []byte) Result {
+	var sum float64
+	for _, b := range bits {
+		sum += 2*float64(b) - 1
+	}
+	sObs := math.Abs(sum) / math.Sqrt(float64(len(bits)))
+	return Result{
+		Name:      "NIST frequency (monobit)",
+		Statistic: sObs,
+		PValue:    math.Erfc(sObs / math.Sqrt2),
+		Detail:    fmt.Sprintf("%d bits", len(bits)),
+	}
+}
+
+// blockFrequencyTest is the NIST SP 800-22 frequency test within a block.
+func blockFrequencyTest(bits []byte) Result {
+	blocks := len(bits) / blockBits
+	var x2 float64
+	for i := 0; i < blocks; i++ {
+		var ones float64
+		for _, b := range bits[i*blockBits : (i+1)*blockBits] {
+			ones += float64(b)
+		}
+		pi := ones/blockBits - 0.5
+		x2 += pi * pi
+	}
+	x2 *= 4 * blockBits
+	return Result{
+		Name:      "NIST block frequency",
+		Statistic: x2,
+		PValue:    igamc(float64(blocks)/2, x2/2),
+		Detail:    fmt.Sprintf("%d blocks of %d bits", blocks, blockBits),
+	}
+}
+
+// toBits expands byte values (0..255) into bits, most significant first.
+func toBits(bytes []int64) []byte {
+	bits := make([]byte, 0, 8*len(bytes))
+	for _, v := range bytes {
+		for i := 7; i >= 0; i-- {
+			bits = append(bits, byte(v>>i)&1)
+		}
+	}
+	return bits
+}
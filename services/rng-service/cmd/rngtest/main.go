@@ -0,0 +1,174 @@
+// Command rngtest runs an offline statistical test battery over RNG output
+// and writes a pass/fail report as JSON and HTML.
+//
+// By default it links the generator directly (health-tested OS entropy, a
+// DRBG and the same rejection sampler the service uses). With -addr it pulls
+// values from a running rng-service over gRPC instead, so the full serving
+// path is covered.
+//
+//	go run ./services/rng-service/cmd/rngtest -n 10000000 -json report.json -html report.html
+//	go run ./services/rng-service/cmd/rngtest -addr localhost:50051
+//
+// The exit status is 1 if any test fails, so the command can gate changes to
+// the RNG service. -alpha is the battery's overall false-failure rate: each
+// test is held to the Šidák-corrected level 1-(1-alpha)^(1/8), so an ideal
+// generator fails a run with probability alpha (1% by default) rather than
+// the 7.7% of eight tests each at alpha.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/draw"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/entropy"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// grpcBatch is the number of draws packed into each stream message.
+const grpcBatch = 10000
+
+// sampler produces n independent draws, each uniform in [0, bound).
+type sampler interface {
+	Draw(bound int64, n int) ([]int64, error)
+	Name() string
+}
+
+type directSampler struct {
+	src *draw.Source
+	alg string
+}
+
+func newDirectSampler(alg string) (*directSampler, error) {
+	ent, err := entropy.NewSource(rand.Reader, entropy.Config{})
+	if err != nil {
+		return nil, err
+	}
+	gen, err := drbg.New(drbg.Config{Algorithm: alg, Entropy: ent})
+	if err != nil {
+		return nil, err
+	}
+	return &directSampler{src: draw.NewSource(gen), alg: gen.Info().Algorithm}, nil
+}
+
+func (d *directSampler) Draw(bound int64, n int) ([]int64, error) {
+	out := make([]int64, n)
+	for i := range out {
+		v, err := d.src.Intn(bound)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (d *directSampler) Name() string { return "direct " + d.alg }
+
+type grpcSampler struct {
+	client pb.RNGServiceClient
+	addr   string
+}
+
+func (g *grpcSampler) Draw(bound int64, n int) ([]int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+	stream, err := g.client.StreamNumbers(ctx, &pb.StreamNumbersRequest{
+		Bounds:           []int64{bound},
+		Frames:           uint64(n),
+		FramesPerMessage: grpcBatch,
+		Caller:           "rngtest",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]int64, 0, n)
+	for len(out) < n {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resp.GetNumbers()...)
+	}
+	return out, nil
+}
+
+func (g *grpcSampler) Name() string { return "rng-service at " + g.addr }
+
+func main() {
+	addr := flag.String("addr", "", "rng-service address; empty links the generator directly")
+	alg := flag.String("drbg", "hmac-sha256", "DRBG for direct mode (hmac-sha256 or ctr-aes256)")
+	n := flag.Int("n", 1000000, "number of values per test")
+	bound := flag.Int64("bound", 256, "exclusive upper bound of the values under test, 2..n/5")
+	alpha := flag.Float64("alpha", 0.01, "family-wise significance level: the chance an ideal generator fails the battery")
+	jsonOut := flag.String("json", "rngtest-report.json", "JSON report path")
+	htmlOut := flag.String("html", "rngtest-report.html", "HTML report path")
+	flag.Parse()
+
+	if *alpha <= 0 || *alpha >= 1 {
+		log.Fatalf("alpha must be in (0, 1)")
+	}
+	// The chi-square test expects each value at least five times.
+	if *bound < 2 || *bound > int64(*n)/5 {
+		log.Fatalf("bound must be in 2..n/5 (%d)", *n/5)
+	}
+
+	var s sampler
+	if *addr == "" {
+		ds, err := newDirectSampler(*alg)
+		if err != nil {
+			log.Fatalf("failed to set up generator: %v", err)
+		}
+		s = ds
+	} else {
+		conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("did not connect to RNG: %v", err)
+		}
+		defer conn.Close()
+		s = &grpcSampler{client: pb.NewRNGServiceClient(conn), addr: *addr}
+	}
+
+	log.Printf("Running battery against %s (n=%d, bound=%d)", s.Name(), *n, *bound)
+	rep, err := runBattery(s, *n, *bound, *alpha)
+	if err != nil {
+		log.Fatalf("battery aborted: %v", err)
+	}
+
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to encode report: %v", err)
+	}
+	if err := os.WriteFile(*jsonOut, data, 0o644); err != nil {
+		log.Fatalf("failed to write JSON report: %v", err)
+	}
+	if err := writeHTML(*htmlOut, rep); err != nil {
+		log.Fatalf("failed to write HTML report: %v", err)
+	}
+
+	for _, r := range rep.Results {
+		fmt.Printf("%-28s p=%.6f  %s\n", r.Name, r.PValue, verdict(r.Pass))
+	}
+	fmt.Printf("overall: %s (per-test alpha %.6f, false-failure rate %.2f%%)\n", verdict(rep.Pass), rep.TestAlpha, 100*rep.Alpha)
+	if !rep.Pass {
+		os.Exit(1)
+	}
+}
+
+func verdict(pass bool) string {
+	if pass {
+		return "PASS"
+	}
+	return "FAIL"
+}
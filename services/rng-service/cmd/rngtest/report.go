@@ -0,0 +1,51 @@
+package main
+
+import (
+	"html/template"
+	"os"
+)
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": func(p float64) float64 { return 100 * p },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>ECHOBETZ RNG test battery</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 10px; text-align: left; }
+.pass { color: #1a7f37; font-weight: bold; }
+.fail { color: #cf222e; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>RNG test battery: <span class="{{if .Pass}}pass">PASS{{else}}fail">FAIL{{end}}</span></h1>
+<p>Generator: {{.Generator}}<br>
+Samples per test: {{.Samples}}, bound: {{.Bound}}<br>
+Per-test alpha: {{printf "%.6f" .TestAlpha}} (Šidák correction over {{len .Results}} tests); overall false-failure rate for an ideal generator: {{printf "%.2f" (percent .Alpha)}}%<br>
+Started: {{.Started.Format "2006-01-02 15:04:05 MST"}}, duration: {{.Duration}}</p>
+<table>
+<tr><th>Test</th><th>Statistic</th><th>p-value</th><th>Result</th><th>Detail</th></tr>
+{{range .Results}}<tr>
+<td>{{.Name}}</td><td>{{printf "%.4f" .Statistic}}</td><td>{{printf "%.6f" .PValue}}</td>
+<td class="{{if .Pass}}pass">PASS{{else}}fail">FAIL{{end}}</td><td>{{.Detail}}</td>
+</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// writeHTML renders rep as a standalone HTML page at path.
+func writeHTML(path string, rep *Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := reportTemplate.Execute(f, rep); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
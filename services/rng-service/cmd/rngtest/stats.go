@@ -0,0 +1,74 @@
+package main
+
+import "math"
+
+// chiSquare returns the Pearson statistic sum((o-e)^2 / e).
+func chiSquare(observed, expected []float64) float64 {
+	var x2 float64
+	for i, o := range observed {
+		d := o - expected[i]
+		x2 += d * d / expected[i]
+	}
+	return x2
+}
+
+// igamc is the regularized upper incomplete gamma function Q(a, x), used for
+// chi-square p-values: p = igamc(df/2, x2/2).
+func igamc(a, x float64) float64 {
+	if x <= 0 || a <= 0 {
+		return 1
+	}
+	if x < a+1 {
+		return 1 - igamSeries(a, x)
+	}
+	return igamContinuedFraction(a, x)
+}
+
+const (
+	igamEps     = 1e-15
+	igamMaxIter = 100000
+)
+
+// igamSeries is P(a, x) by its power series, for x < a+1.
+func igamSeries(a, x float64) float64 {
+	lg, _ := math.Lgamma(a)
+	sum := 1 / a
+	term := sum
+	for n := 1; n < igamMaxIter; n++ {
+		term *= x / (a + float64(n))
+		sum += term
+		if math.Abs(term) < math.Abs(sum)*igamEps {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lg)
+}
+
+// igamContinuedFraction is Q(a, x) by Lentz's continued fraction, for x >= a+1.
+func igamContinuedFraction(a, x float64) float64 {
+	const tiny = 1e-300
+	lg, _ := math.Lgamma(a)
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < igamMaxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < igamEps {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// near reports whether got is within tol of want.
+func near(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol
+}
+
+func TestIgamc(t *testing.T) {
+	tests := []struct {
+		a, x, want, tol float64
+	}{
+		{1, 2, math.Exp(-2), 1e-14},
+		{3, 1, 2.5 / math.E, 1e-14},
+		// One degree of freedom: Q(1/2, x) = erfc(sqrt(x)).
+		{0.5, 0.5, math.Erfc(math.Sqrt(0.5)), 1e-14},
+		{0.5, 8, math.Erfc(math.Sqrt(8)), 1e-14},
+		// NIST SP 800-22 section 2.2.8: chi-square 7.2 over 10 blocks.
+		{5, 3.6, 0.706438, 1e-6},
+		// The 5% critical value of chi-square with 10 degrees of freedom.
+		{5, 18.307 / 2, 0.05, 1e-4},
+		{10, 0, 1, 0},
+	}
+	for _, tt := range tests {
+		if got := igamc(tt.a, tt.x); !near(got, tt.want, tt.tol) {
+			t.Errorf("igamc(%v, %v) = %.15g, want %.15g", tt.a, tt.x, got, tt.want)
+		}
+	}
+
+	// For integer a, Q(a, x) is the Poisson tail e^-x sum_{k<a} x^k/k!.
+	for _, a := range []int{2, 20, 128} {
+		for _, x := range []float64{0.5 * float64(a), float64(a), 1.5 * float64(a)} {
+			var sum float64
+			term := math.Exp(-x)
+			for k := 0; k < a; k++ {
+				sum += term
+				term *= x / float64(k+1)
+			}
+			if got := igamc(float64(a), x); !near(got, sum, 1e-12) {
+				t.Errorf("igamc(%d, %v) = %.15g, want %.15g", a, x, got, sum)
+			}
+		}
+	}
+}
+
+func TestSidak(t *testing.T) {
+	if got := sidak(0.01, 1); !near(got, 0.01, 1e-15) {
+		t.Errorf("sidak(0.01, 1) = %v, want 0.01", got)
+	}
+	if got := sidak(0.05, 8); !near(got, 0.0063911, 1e-7) {
+		t.Errorf("sidak(0.05, 8) = %v, want 0.0063911", got)
+	}
+	// k tests at the corrected level fail together at the family-wise level.
+	for _, k := range []int{2, 8, 100} {
+		a := sidak(0.01, k)
+		if got := -math.Expm1(float64(k) * math.Log1p(-a)); !near(got, 0.01, 1e-12) {
+			t.Errorf("family-wise level over %d tests %v, want 0.01", k, got)
+		}
+	}
+}
+
+// piBits returns the first 100 bits of the binary expansion of pi, the
+// sequence of the NIST SP 800-22 examples.
+func piBits() []byte {
+	const hex = "3243F6A8885A308D313198A2E0370734"
+	var bits []byte
+	for _, c := range hex {
+		v := int64(c - '0')
+		if c >= 'A' {
+			v = int64(c-'A') + 10
+		}
+		for i := 3; i >= 0; i-- {
+			bits = append(bits, byte(v>>i)&1)
+		}
+	}
+	return bits[2:102]
+}
+
+func TestMonobit(t *testing.T) {
+	// NIST SP 800-22 section 2.1.8: s_obs = 1.6, p = 0.109599.
+	r := monobitTest(piBits())
+	if !near(r.Statistic, 1.6, 1e-12) || !near(r.PValue, 0.109599, 1e-6) {
+		t.Errorf("statistic %v, p %v; want 1.6 and 0.109599", r.Statistic, r.PValue)
+	}
+}
+
+func TestBlockFrequency(t *testing.T) {
+	// One block of ones and one balanced block: chi-square 4*128*(1/4),
+	// with two degrees of freedom, p = e^-64.
+	bits := make([]byte, 2*blockBits)
+	for i := range bits[:blockBits] {
+		bits[i] = 1
+	}
+	for i := blockBits; i < len(bits); i += 2 {
+		bits[i] = 1
+	}
+	r := blockFrequencyTest(bits)
+	if !near(r.Statistic, 128, 1e-12) || !near(r.PValue/math.Exp(-64), 1, 1e-12) {
+		t.Errorf("statistic %v, p %v; want 128 and e^-64", r.Statistic, r.PValue)
+	}
+}
+
+func TestChiSquare(t *testing.T) {
+	// Counts 3 and 1 against 2 and 2: chi-square 1 with one degree of
+	// freedom, p = 0.3173.
+	r := chiSquareTest([]int64{0, 0, 0, 1}, 2)
+	if !near(r.Statistic, 1, 1e-15) || !near(r.PValue, 0.317311, 1e-6) {
+		t.Errorf("statistic %v, p %v; want 1 and 0.317311", r.Statistic, r.PValue)
+	}
+}
+
+func TestRuns(t *testing.T) {
+	// Twenty alternating values above and below the median make 20 runs
+	// against 11 expected: z = 9 / sqrt(90/19) = 4.1352.
+	values := make([]int64, 20)
+	for i := range values {
+		values[i] = int64(i % 2)
+	}
+	r := runsTest(values, 2)
+	if !near(r.Statistic, 4.1352, 1e-4) || !near(r.PValue, math.Erfc(4.1352/math.Sqrt2), 1e-6) {
+		t.Errorf("statistic %v, p %v; want z 4.1352", r.Statistic, r.PValue)
+	}
+}
+
+func TestToBits(t *testing.T) {
+	got := toBits([]int64{0xA5, 0x01})
+	want := []byte{1, 0, 1, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1}
+	if string(got) != string(want) {
+		t.Errorf("toBits = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// rateWindow is how often the cycler recomputes its observed cycle rate.
+const rateWindow = 10 * time.Second
+
+// cycler keeps every RNG stream advancing while the service is idle, so the
+// moment a draw is requested does not determine its value. At randomised
+// intervals (uniform in [interval/2, 3*interval/2), drawn from the default
+// stream) it generates and discards one block from each stream.
+type cycler struct {
+	streams  *streamSet
+	interval time.Duration
+
+	mu     sync.Mutex
+	total  uint64  // cycles across all streams
+	rate   float64 // cycles per second over the last rateWindow
+	errors uint64  // failed cycles
+}
+
+// run cycles the streams until ctx is done.
+func (c *cycler) run(ctx context.Context) {
+	timer := time.NewTimer(c.next())
+	defer timer.Stop()
+	ticker := time.NewTicker(rateWindow)
+	defer ticker.Stop()
+
+	var windowStart uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			c.rate = float64(c.total-windowStart) / rateWindow.Seconds()
+			windowStart = c.total
+			c.mu.Unlock()
+		case <-timer.C:
+			c.cycle()
+			timer.Reset(c.next())
+		}
+	}
+}
+
+// cycle advances every stream by one generate request. It takes each
+// stream's draw lock, so it never interleaves with an audited draw.
+func (c *cycler) cycle() {
+	var ok, failed uint64
+	for _, st := range c.streams.all() {
+		st.mu.Lock()
+		_, err := st.src.Uint64()
+		if err == nil {
+			st.cycles++
+		}
+		st.mu.Unlock()
+
+		if err != nil {
+			failed++
+			if failed == 1 {
+				log.Printf("RNG stream %q: background cycle failed: %v", st.name, err)
+			}
+			continue
+		}
+		ok++
+	}
+
+	c.mu.Lock()
+	c.total += ok
+	c.errors += failed
+	c.mu.Unlock()
+}
+
+// next returns the randomised delay before the next cycle.
+func (c *cycler) next() time.Duration {
+	st, err := c.streams.get(defaultStream)
+	if err != nil {
+		return c.interval
+	}
+	st.mu.Lock()
+	j, err := st.src.Intn(int64(c.interval))
+	st.mu.Unlock()
+	if err != nil {
+		return c.interval
+	}
+	return c.interval/2 + time.Duration(j)
+}
+
+// stats returns the total cycles, recent rate and failures.
+func (c *cycler) stats() (total uint64, rate float64, errors uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.total, c.rate, c.errors
+}
@@ -0,0 +1,70 @@
+// Package draw turns a cryptographically secure byte stream into unbiased
+// bounded integers. All randomness handed out by the RNG service goes
+// through a Source.
+package draw
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+)
+
+// Source draws unbiased integers from a cryptographically secure byte stream.
+type Source struct {
+	r io.Reader
+}
+
+// NewSource returns a Source drawing from r, normally a *drbg.DRBG.
+func NewSource(r io.Reader) *Source {
+	return &Source{r: r}
+}
+
+// Uint64 returns 64 uniformly random bits.
+func (s *Source) Uint64() (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(s.r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
+
+// Intn returns a uniform value in [0, bound) using rejection sampling.
+// Raw 64-bit values at or above the largest multiple of bound are discarded,
+// so every result in the range is exactly equally likely (no modulo bias).
+func (s *Source) Intn(bound int64) (int64, error) {
+	if bound <= 0 {
+		return 0, errors.New("rng: bound must be positive")
+	}
+	n := uint64(bound)
+	// limit is the largest multiple of n that fits in a uint64 (exclusive).
+	limit := math.MaxUint64 - (math.MaxUint64%n+1)%n
+	for {
+		v, err := s.Uint64()
+		if err != nil {
+			return 0, err
+		}
+		if v <= limit {
+			return int64(v % n), nil
+		}
+	}
+}
+
+// Bytes returns n random bytes.
+func (s *Source) Bytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(s.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// AuditID returns a random 128-bit identifier (hex encoded) for a draw.
+func (s *Source) AuditID() (string, error) {
+	var b [16]byte
+	if _, err := io.ReadFull(s.r, b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,131 @@
+package drbg
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+)
+
+const (
+	ctrKeyLen   = 32
+	ctrBlockLen = aes.BlockSize
+	ctrSeedLen  = ctrKeyLen + ctrBlockLen
+)
+
+// CTR is CTR_DRBG (SP 800-90A section 10.2.1) instantiated with AES-256 and
+// no derivation function. Entropy input must therefore be full-entropy and
+// exactly seedlen (48 bytes); personalization and additional input are at
+// most seedlen bytes.
+type CTR struct {
+	block         cipher.Block
+	v             [ctrBlockLen]byte
+	reseedCounter uint64
+}
+
+// NewCTR instantiates CTR_DRBG from entropy input and an optional
+// personalization string (section 10.2.1.3.1).
+func NewCTR(entropy, personalization []byte) (*CTR, error) {
+	if len(entropy) != ctrSeedLen {
+		return nil, errors.New("drbg: CTR_DRBG entropy input must be 48 bytes")
+	}
+	if len(personalization) > ctrSeedLen {
+		return nil, errors.New("drbg: personalization string too long")
+	}
+
+	d := &CTR{}
+	d.setKey(make([]byte, ctrKeyLen))
+	seed := xorPad(entropy, personalization)
+	d.update(&seed)
+	d.reseedCounter = 1
+	return d, nil
+}
+
+func (d *CTR) setKey(key []byte) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err) // key length is fixed
+	}
+	d.block = block
+}
+
+// update is CTR_DRBG_Update (section 10.2.1.2).
+func (d *CTR) update(provided *[ctrSeedLen]byte) {
+	var temp [ctrSeedLen]byte
+	for i := 0; i < ctrSeedLen; i += ctrBlockLen {
+		d.increment()
+		d.block.Encrypt(temp[i:i+ctrBlockLen], d.v[:])
+	}
+	for i := range temp {
+		temp[i] ^= provided[i]
+	}
+	d.setKey(temp[:ctrKeyLen])
+	copy(d.v[:], temp[ctrKeyLen:])
+}
+
+// increment adds one to V modulo 2^128.
+func (d *CTR) increment() {
+	for i := len(d.v) - 1; i >= 0; i-- {
+		d.v[i]++
+		if d.v[i] != 0 {
+			return
+		}
+	}
+}
+
+// Reseed implements CTR_DRBG_Reseed_algorithm (section 10.2.1.4.1).
+func (d *CTR) Reseed(entropy, additional []byte) error {
+	if len(entropy) != ctrSeedLen {
+		return errors.New("drbg: CTR_DRBG entropy input must be 48 bytes")
+	}
+	if len(additional) > ctrSeedLen {
+		return errors.New("drbg: additional input too long")
+	}
+	seed := xorPad(entropy, additional)
+	d.update(&seed)
+	d.reseedCounter = 1
+	return nil
+}
+
+// Generate implements CTR_DRBG_Generate_algorithm (section 10.2.1.5.1).
+func (d *CTR) Generate(out, additional []byte) error {
+	if len(out) > maxRequestBytes {
+		return errors.New("drbg: request too large")
+	}
+	if len(additional) > ctrSeedLen {
+		return errors.New("drbg: additional input too long")
+	}
+	if d.reseedCounter > MaxReseedInterval {
+		return ErrReseedRequired
+	}
+
+	var add [ctrSeedLen]byte
+	if len(additional) > 0 {
+		copy(add[:], additional)
+		d.update(&add)
+	}
+	var block [ctrBlockLen]byte
+	for n := 0; n < len(out); {
+		d.increment()
+		d.block.Encrypt(block[:], d.v[:])
+		n += copy(out[n:], block[:])
+	}
+	d.update(&add)
+	d.reseedCounter++
+	return nil
+}
+
+// ReseedCounter returns the current reseed counter.
+func (d *CTR) ReseedCounter() uint64 { return d.reseedCounter }
+
+// Name returns AlgCTRAES256.
+func (d *CTR) Name() string { return AlgCTRAES256 }
+
+// xorPad returns a XOR b, with b zero-padded to seedlen.
+func xorPad(a, b []byte) [ctrSeedLen]byte {
+	var out [ctrSeedLen]byte
+	copy(out[:], a)
+	for i, c := range b {
+		out[i] ^= c
+	}
+	return out
+}
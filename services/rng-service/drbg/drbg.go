@@ -0,0 +1,209 @@
+// Package drbg implements the NIST SP 800-90A Rev. 1 deterministic random bit
+// generators used by the RNG service: HMAC_DRBG with SHA-256 and CTR_DRBG with
+// AES-256 (no derivation function). Both run at a 256-bit security strength.
+package drbg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Algorithm names as reported to auditors.
+const (
+	AlgHMACSHA256 = "HMAC_DRBG-SHA256"
+	AlgCTRAES256  = "CTR_DRBG-AES256"
+)
+
+const (
+	// SecurityStrength is the security strength of both mechanisms, in bytes.
+	SecurityStrength = 32
+
+	// MaxReseedInterval is the SP 800-90A limit on generate requests between
+	// reseeds (2^48) for both mechanisms.
+	MaxReseedInterval = 1 << 48
+
+	// maxRequestBytes is the largest single generate request (2^19 bits).
+	maxRequestBytes = (1 << 19) / 8
+
+	// maxInputBytes bounds personalization strings and additional input.
+	maxInputBytes = 1 << 16
+)
+
+// ErrReseedRequired is returned by Generate once the reseed interval has been
+// reached; the caller must Reseed before generating again.
+var ErrReseedRequired = errors.New("drbg: reseed required")
+
+// Mechanism is an instantiated SP 800-90A DRBG algorithm.
+type Mechanism interface {
+	// Reseed mixes fresh entropy and optional additional input into the state.
+	Reseed(entropy, additional []byte) error
+	// Generate fills out with pseudorandom bits. len(out) must not exceed
+	// the maximum request size.
+	Generate(out, additional []byte) error
+	// ReseedCounter is the number of generate requests since the last reseed, plus one.
+	ReseedCounter() uint64
+	// Name returns the algorithm name, e.g. AlgHMACSHA256.
+	Name() string
+}
+
+// Config selects and parameterises a DRBG.
+type Config struct {
+	// Algorithm is "hmac-sha256" (default) or "ctr-aes256".
+	Algorithm string
+	// ReseedInterval is the number of generate requests between reseeds.
+	// Zero means MaxReseedInterval.
+	ReseedInterval uint64
+	// PredictionResistance reseeds from Entropy before every generate request.
+	PredictionResistance bool
+	// Personalization is mixed into the initial state.
+	Personalization []byte
+	// Entropy supplies entropy input (and the nonce). It must be a full-entropy
+	// source such as crypto/rand.Reader.
+	Entropy io.Reader
+}
+
+// DRBG wraps a Mechanism with its entropy source and reseed policy.
+// It is safe for concurrent use and implements io.Reader.
+type DRBG struct {
+	mu       sync.Mutex
+	mech     Mechanism
+	entropy  io.Reader
+	interval uint64
+	pr       bool
+
+	generated uint64 // total generate requests since instantiation
+	reseeds   uint64 // total reseeds since instantiation
+}
+
+// New instantiates the DRBG described by cfg, seeding it from cfg.Entropy.
+func New(cfg Config) (*DRBG, error) {
+	if cfg.Entropy == nil {
+		return nil, errors.New("drbg: no entropy source")
+	}
+	interval := cfg.ReseedInterval
+	if interval == 0 {
+		interval = MaxReseedInterval
+	}
+	if interval > MaxReseedInterval {
+		return nil, fmt.Errorf("drbg: reseed interval %d exceeds maximum %d", interval, uint64(MaxReseedInterval))
+	}
+
+	var mech Mechanism
+	switch strings.ToLower(cfg.Algorithm) {
+	case "", "hmac", "hmac-sha256":
+		entropy, err := readEntropy(cfg.Entropy, SecurityStrength)
+		if err != nil {
+			return nil, err
+		}
+		nonce, err := readEntropy(cfg.Entropy, SecurityStrength/2)
+		if err != nil {
+			return nil, err
+		}
+		mech, err = NewHMAC(entropy, nonce, cfg.Personalization)
+		if err != nil {
+			return nil, err
+		}
+	case "ctr", "ctr-aes256":
+		entropy, err := readEntropy(cfg.Entropy, ctrSeedLen)
+		if err != nil {
+			return nil, err
+		}
+		mech, err = NewCTR(entropy, cfg.Personalization)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("drbg: unknown algorithm %q", cfg.Algorithm)
+	}
+
+	return &DRBG{mech: mech, entropy: cfg.Entropy, interval: interval, pr: cfg.PredictionResistance}, nil
+}
+
+// Read fills p with pseudorandom bytes, splitting large reads into
+// maximum-size generate requests and reseeding as the policy requires.
+func (d *DRBG) Read(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n := 0
+	for n < len(p) {
+		chunk := p[n:]
+		if len(chunk) > maxRequestBytes {
+			chunk = chunk[:maxRequestBytes]
+		}
+		if err := d.generate(chunk); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+	}
+	return n, nil
+}
+
+func (d *DRBG) generate(out []byte) error {
+	if d.pr || d.mech.ReseedCounter() > d.interval {
+		if err := d.reseed(nil); err != nil {
+			return err
+		}
+	}
+	if err := d.mech.Generate(out, nil); err != nil {
+		return err
+	}
+	d.generated++
+	return nil
+}
+
+// Reseed forces a reseed from the entropy source, mixing in additional input.
+func (d *DRBG) Reseed(additional []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.reseed(additional)
+}
+
+func (d *DRBG) reseed(additional []byte) error {
+	n := SecurityStrength
+	if d.mech.Name() == AlgCTRAES256 {
+		n = ctrSeedLen
+	}
+	entropy, err := readEntropy(d.entropy, n)
+	if err != nil {
+		return err
+	}
+	if err := d.mech.Reseed(entropy, additional); err != nil {
+		return err
+	}
+	d.reseeds++
+	return nil
+}
+
+// Info describes the running DRBG for audit and logging.
+type Info struct {
+	Algorithm            string
+	ReseedInterval       uint64
+	PredictionResistance bool
+	Generated            uint64
+	Reseeds              uint64
+}
+
+// Info returns the algorithm, reseed policy and counters of d.
+func (d *DRBG) Info() Info {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return Info{
+		Algorithm:            d.mech.Name(),
+		ReseedInterval:       d.interval,
+		PredictionResistance: d.pr,
+		Generated:            d.generated,
+		Reseeds:              d.reseeds,
+	}
+}
+
+func readEntropy(r io.Reader, n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, fmt.Errorf("drbg: reading entropy: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,231 @@
+package drbg
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The known answers are the CAVP and ACVP vectors in selftest.go: HMAC_DRBG
+// SHA-256 without reseed, and CTR_DRBG AES-256 without a derivation function
+// with personalization, reseed and additional input. TestCTRKnownAnswerCAST
+// adds the Go standard library's CTR_DRBG self-test vector. The prediction
+// resistance tests check the SP 800-90A section 9.3.1 construction against
+// the mechanisms those vectors validate.
+
+func TestHMACKnownAnswer(t *testing.T) {
+	h, err := NewHMAC(mustHex(hmacKAT.entropy), mustHex(hmacKAT.nonce), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := mustHex(hmacKAT.returned)
+	got := make([]byte, len(want))
+	for i := 0; i < 2; i++ {
+		if err := h.Generate(got, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("returned bits\n%x\nwant\n%x", got, want)
+	}
+	if h.ReseedCounter() != 3 {
+		t.Errorf("reseed counter %d, want 3", h.ReseedCounter())
+	}
+}
+
+func TestCTRKnownAnswer(t *testing.T) {
+	c, err := NewCTR(mustHex(ctrKAT.entropy), mustHex(ctrKAT.personalization))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Reseed(mustHex(ctrKAT.reseedEntropy), mustHex(ctrKAT.reseedAdditional)); err != nil {
+		t.Fatal(err)
+	}
+	if c.ReseedCounter() != 1 {
+		t.Errorf("reseed counter after reseed %d, want 1", c.ReseedCounter())
+	}
+	want := mustHex(ctrKAT.returned)
+	got := make([]byte, len(want))
+	if err := c.Generate(got, mustHex(ctrKAT.additional1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Generate(got, mustHex(ctrKAT.additional2)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("returned bits\n%x\nwant\n%x", got, want)
+	}
+}
+
+// seq returns n bytes counting up from first.
+func seq(first byte, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = first + byte(i)
+	}
+	return b
+}
+
+func TestCTRKnownAnswerCAST(t *testing.T) {
+	// The CTR_DRBG conditional self-test of Go's crypto/internal/fips140/drbg
+	// (cast.go): instantiate without personalization, reseed and generate
+	// with the same additional input.
+	c, err := NewCTR(seq(0x01, ctrSeedLen), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	additional := seq(0x61, ctrSeedLen)
+	if err := c.Reseed(seq(0x31, ctrSeedLen), additional); err != nil {
+		t.Fatal(err)
+	}
+	want := mustHex("6e6e479d24f86a3b7787a8f8186d985a53bebeeddeab9228f0f4ac6e10bf0193")
+	got := make([]byte, len(want))
+	if err := c.Generate(got, additional); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("returned bits\n%x\nwant\n%x", got, want)
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// counterEntropy returns n bytes of distinct, known entropy input.
+func counterEntropy(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i*7 + 1)
+	}
+	return b
+}
+
+func TestPredictionResistance(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		seedLen   int // entropy read per reseed
+		instLen   int // entropy read to instantiate, with the nonce
+		mech      func(entropy []byte) (Mechanism, error)
+	}{
+		{"hmac-sha256", SecurityStrength, SecurityStrength * 3 / 2, func(e []byte) (Mechanism, error) {
+			return NewHMAC(e[:SecurityStrength], e[SecurityStrength:], nil)
+		}},
+		{"ctr-aes256", ctrSeedLen, ctrSeedLen, func(e []byte) (Mechanism, error) {
+			return NewCTR(e, nil)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			const requests = 3
+			entropy := counterEntropy(tt.instLen + requests*tt.seedLen)
+			d, err := New(Config{Algorithm: tt.algorithm, PredictionResistance: true, Entropy: bytes.NewReader(entropy)})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// With prediction resistance every generate request is preceded
+			// by a reseed with fresh entropy input (EntropyInputPR).
+			ref, err := tt.mech(entropy[:tt.instLen])
+			if err != nil {
+				t.Fatal(err)
+			}
+			pr := entropy[tt.instLen:]
+			for i := 0; i < requests; i++ {
+				got := make([]byte, 64)
+				if _, err := d.Read(got); err != nil {
+					t.Fatal(err)
+				}
+				if err := ref.Reseed(pr[:tt.seedLen], nil); err != nil {
+					t.Fatal(err)
+				}
+				pr = pr[tt.seedLen:]
+				want := make([]byte, len(got))
+				if err := ref.Generate(want, nil); err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Fatalf("request %d: got %x, want %x", i, got, want)
+				}
+			}
+			if info := d.Info(); info.Reseeds != requests || info.Generated != requests {
+				t.Errorf("%d reseeds and %d requests, want %d each", info.Reseeds, info.Generated, requests)
+			}
+
+			// The entropy source is exhausted, so the next request cannot
+			// reseed and must fail rather than generate without one.
+			if _, err := d.Read(make([]byte, 16)); err == nil {
+				t.Error("generate without prediction resistance entropy succeeded")
+			}
+		})
+	}
+}
+
+func TestReseedInterval(t *testing.T) {
+	for _, algorithm := range []string{"hmac-sha256", "ctr-aes256"} {
+		t.Run(algorithm, func(t *testing.T) {
+			d, err := New(Config{Algorithm: algorithm, ReseedInterval: 2, Entropy: bytes.NewReader(counterEntropy(1024))})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, reseeds := range []uint64{0, 0, 1, 1, 2} {
+				if _, err := d.Read(make([]byte, 16)); err != nil {
+					t.Fatal(err)
+				}
+				if got := d.Info().Reseeds; got != reseeds {
+					t.Errorf("after request %d: %d reseeds, want %d", i+1, got, reseeds)
+				}
+			}
+		})
+	}
+}
+
+func TestAdditionalInput(t *testing.T) {
+	entropy := counterEntropy(2 * ctrSeedLen)
+	mechs := map[string]func() (Mechanism, error){
+		AlgHMACSHA256: func() (Mechanism, error) { return NewHMAC(entropy[:32], entropy[32:48], nil) },
+		AlgCTRAES256:  func() (Mechanism, error) { return NewCTR(entropy[:ctrSeedLen], nil) },
+	}
+	for name, mech := range mechs {
+		t.Run(name, func(t *testing.T) {
+			// Each pair starts from the same state and differs only in the
+			// additional input.
+			pair := func() (Mechanism, Mechanism) {
+				a, err := mech()
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := mech()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return a, b
+			}
+			outA, outB := make([]byte, 32), make([]byte, 32)
+
+			a, b := pair()
+			if err := a.Reseed(entropy[ctrSeedLen:], nil); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.Reseed(entropy[ctrSeedLen:], []byte("reseed input")); err != nil {
+				t.Fatal(err)
+			}
+			a.Generate(outA, nil)
+			b.Generate(outB, nil)
+			if bytes.Equal(outA, outB) {
+				t.Error("reseed additional input did not change the output")
+			}
+
+			a, b = pair()
+			a.Generate(outA, nil)
+			b.Generate(outB, []byte("generate input"))
+			if bytes.Equal(outA, outB) {
+				t.Error("generate additional input did not change the output")
+			}
+			if err := a.Generate(outA, make([]byte, maxInputBytes+1)); err == nil {
+				t.Error("oversized additional input accepted")
+			}
+		})
+	}
+}
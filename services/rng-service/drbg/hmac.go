@@ -0,0 +1,112 @@
+package drbg
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+// HMAC is HMAC_DRBG (SP 800-90A section 10.1.2) instantiated with SHA-256.
+type HMAC struct {
+	k, v          []byte
+	reseedCounter uint64
+}
+
+// NewHMAC instantiates HMAC_DRBG from entropy input, a nonce and an optional
+// personalization string (section 10.1.2.3).
+func NewHMAC(entropy, nonce, personalization []byte) (*HMAC, error) {
+	if len(entropy) < SecurityStrength {
+		return nil, errors.New("drbg: insufficient entropy input")
+	}
+	if len(nonce) < SecurityStrength/2 {
+		return nil, errors.New("drbg: nonce too short")
+	}
+	if len(personalization) > maxInputBytes {
+		return nil, errors.New("drbg: personalization string too long")
+	}
+
+	d := &HMAC{
+		k: make([]byte, sha256.Size),
+		v: make([]byte, sha256.Size),
+	}
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+	d.update(entropy, nonce, personalization)
+	d.reseedCounter = 1
+	return d, nil
+}
+
+// update is HMAC_DRBG_Update (section 10.1.2.2). The provided data is the
+// concatenation of its arguments.
+func (d *HMAC) update(data ...[]byte) {
+	empty := true
+	for _, b := range data {
+		if len(b) > 0 {
+			empty = false
+		}
+	}
+
+	for _, sep := range []byte{0x00, 0x01} {
+		if sep == 0x01 && empty {
+			return
+		}
+		m := hmac.New(sha256.New, d.k)
+		m.Write(d.v)
+		m.Write([]byte{sep})
+		for _, b := range data {
+			m.Write(b)
+		}
+		d.k = m.Sum(nil)
+
+		m = hmac.New(sha256.New, d.k)
+		m.Write(d.v)
+		d.v = m.Sum(nil)
+	}
+}
+
+// Reseed implements HMAC_DRBG_Reseed (section 10.1.2.4).
+func (d *HMAC) Reseed(entropy, additional []byte) error {
+	if len(entropy) < SecurityStrength {
+		return errors.New("drbg: insufficient entropy input")
+	}
+	if len(additional) > maxInputBytes {
+		return errors.New("drbg: additional input too long")
+	}
+	d.update(entropy, additional)
+	d.reseedCounter = 1
+	return nil
+}
+
+// Generate implements HMAC_DRBG_Generate (section 10.1.2.5).
+func (d *HMAC) Generate(out, additional []byte) error {
+	if len(out) > maxRequestBytes {
+		return errors.New("drbg: request too large")
+	}
+	if len(additional) > maxInputBytes {
+		return errors.New("drbg: additional input too long")
+	}
+	if d.reseedCounter > MaxReseedInterval {
+		return ErrReseedRequired
+	}
+
+	if len(additional) > 0 {
+		d.update(additional)
+	}
+	m := hmac.New(sha256.New, d.k)
+	for n := 0; n < len(out); {
+		m.Reset()
+		m.Write(d.v)
+		d.v = m.Sum(d.v[:0])
+		n += copy(out[n:], d.v)
+	}
+	d.update(additional)
+	d.reseedCounter++
+	return nil
+}
+
+// ReseedCounter returns the current reseed counter.
+func (d *HMAC) ReseedCounter() uint64 { return d.reseedCounter }
+
+// Name returns AlgHMACSHA256.
+func (d *HMAC) Name() string { return AlgHMACSHA256 }
@@ -0,0 +1,115 @@
+package drbg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+)
+
+// Known-answer tests from the NIST CAVP / ACVP DRBG validation suites. They
+// run at service startup (SelfTest) so a broken build can never serve draws.
+
+// hmacKAT is HMAC_DRBG.rsp [SHA-256], PredictionResistance = False,
+// no personalization or additional input, COUNT = 0.
+var hmacKAT = struct {
+	entropy, nonce, returned string
+}{
+	entropy: "ca851911349384bffe89de1cbdc46e6831e44d34a4fb935ee285dd14b71a7488",
+	nonce:   "659ba96c601dc69fc902940805ec0ca8",
+	returned: "" +
+		"e528e9abf2dece54d47c7e75e5fe302149f817ea9fb4bee6f4199697d04d5b89" +
+		"d54fbb978a15b5c443c9ec21036d2460b6f73ebad0dc2aba6e624abf07745bc1" +
+		"07694bb7547bb0995f70de25d6b29e2d3011bb19d27676c07162c8b5ccde0668" +
+		"961df86803482cb37ed6d5c0bb8d50cf1f50d476aa0458bdaba806f48be9dcb8",
+}
+
+// ctrKAT is ACVP ctrDRBG-1.0, AES-256, derivation function not used, with
+// personalization, reseed and additional input (ACVP-Server gen-val
+// ctrDRBG-1.0/prompt.json, the same vector the Go FIPS module validates).
+var ctrKAT = struct {
+	entropy, personalization, reseedEntropy, reseedAdditional string
+	additional1, additional2, returned                        string
+}{
+	entropy: "" +
+		"9fcbb4ccc0135c484bded061da9fd70748682fe84166b97ff53f9aa1909b2e95" +
+		"d3d529c0f453b3ac575d12aa441cc5cd",
+	personalization: "" +
+		"2c9fed0b39556cdbe699ebca2a0ec7eecb287e8744475050c572fa8ae9ed0a4a" +
+		"7d6f1cabf1c4278532fb20af7d64bd32",
+	reseedEntropy: "" +
+		"913c0da19b010eddd55a7a4f3f713eef5b1534d34360a7ec376ae71a6b340043" +
+		"cc7726f762cb853453f399b3a645062a",
+	reseedAdditional: "" +
+		"2d9d4ec141a22e6cd2f6ee4f6719cf6bdf95cfe50b8d5ea6c87d38b4b872706f" +
+		"ff80b0380bb90e9c42d11d6526e56c29",
+	additional1: "" +
+		"a642f06d327828f3e84564a3e37d60c157073b95864ca07981b0189668a0d978" +
+		"cd5dc68f06801ceff0dc839a312b028e",
+	additional2: "" +
+		"9db14babfa9107c88ba92073c0b4a65e89147ea06d74b894142979482f452915" +
+		"b35b5636f9b8a951759735ade7c8d5d1",
+	returned: "" +
+		"f10c645683ff0131254052ed4c698122b46b563654c29d728ac191ca4aaefe64" +
+		"9eefe4c6fc33b25bb739294dd5cf578099f856c98d98000cbf971f1e6ea90082" +
+		"2ff8c110118f6520471744d3f8a3f5c7d568494240e57f5488af9c9f9f4e7322" +
+		"f56ccd843c0dbfce9170c02e205389420527f23edb3369d9fcc5e34901b5ba4e" +
+		"b71b973fc7982ffe0899ff7fe53ee0c4f51a3ef93ef9c6d4d279dd7536f8776b" +
+		"e94aaa05e89ef6e6aee8832b4b42ffca5fb91ec0273f9ef945865512889b0c5e" +
+		"e141d1b38df827d2a694835561628c6f9b093a01a835f07adbb9e03febf93389" +
+		"e8f3b86e1e0abf1f9958fa286ad995289c2f606d1a9043a166c1afe8d00769c7" +
+		"12650819c9068a4bd22717c98338395a7ba6e95b5178bfbf4efb0f05a91713ba" +
+		"8bf2127a6ba1edfa6d1cab05c03ee0d2afe1da4eb8f2c579ec872ff4b602027e" +
+		"f4bdcf2f4b01423f8e600a13d7cacb6ab83263ba58f907694af614a6724fd0e4" +
+		"c627a0d91ddc6716c697face6f4808a4f37b731de4e0cd4766ceadaaaf479925" +
+		"05299c72ac1a6e9a8335b8d7e501b3841188d0da4de5267674444dc2b0cf9f01" +
+		"0756fa865a25ca3f1b24c34e845b2259926b6a867a7684de68a6137c4fb0f47a" +
+		"2e54ae9e6455beba0b0a9629644fe9e378ee95386443ba977124ffd1192e9f46" +
+		"0684c7b09fa99f5f93f04f56fd7955e042187887ce696f1934017e458b16b5c9",
+}
+
+// SelfTest runs the known-answer tests for both mechanisms.
+func SelfTest() error {
+	h, err := NewHMAC(mustHex(hmacKAT.entropy), mustHex(hmacKAT.nonce), nil)
+	if err != nil {
+		return err
+	}
+	want := mustHex(hmacKAT.returned)
+	got := make([]byte, len(want))
+	if err := h.Generate(got, nil); err != nil {
+		return err
+	}
+	if err := h.Generate(got, nil); err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("drbg: %s known-answer test failed", AlgHMACSHA256)
+	}
+
+	c, err := NewCTR(mustHex(ctrKAT.entropy), mustHex(ctrKAT.personalization))
+	if err != nil {
+		return err
+	}
+	if err := c.Reseed(mustHex(ctrKAT.reseedEntropy), mustHex(ctrKAT.reseedAdditional)); err != nil {
+		return err
+	}
+	want = mustHex(ctrKAT.returned)
+	got = make([]byte, len(want))
+	if err := c.Generate(got, mustHex(ctrKAT.additional1)); err != nil {
+		return err
+	}
+	if err := c.Generate(got, mustHex(ctrKAT.additional2)); err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("drbg: %s known-answer test failed", AlgCTRAES256)
+	}
+	return nil
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
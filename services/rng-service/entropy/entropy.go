@@ -0,0 +1,193 @@
+// Package entropy wraps the raw entropy input of the RNG service with the
+// NIST SP 800-90B continuous health tests (section 4.4): the Repetition Count
+// Test and the Adaptive Proportion Test. Each byte read from the underlying
+// source is one 8-bit sample.
+//
+// A failure is latched: once a test fails, every later Read returns an error
+// wrapping ErrHealthTestFailed until the process is restarted.
+package entropy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+)
+
+// ErrHealthTestFailed is wrapped by all health test failures.
+var ErrHealthTestFailed = errors.New("entropy: health test failed")
+
+const (
+	// alphaLog2 is -log2 of the false positive probability α = 2^-20
+	// recommended by SP 800-90B.
+	alphaLog2 = 20
+
+	// aptWindow is the Adaptive Proportion Test window for non-binary samples.
+	aptWindow = 512
+
+	// startupSamples is the number of samples tested and discarded before the
+	// source is first used (SP 800-90B section 4.3, requirement 12).
+	startupSamples = 1024
+
+	// DefaultMinEntropy is the claimed min-entropy per 8-bit sample. It is
+	// deliberately conservative so that a healthy source essentially never
+	// trips a test, while a stuck or badly degraded one does.
+	DefaultMinEntropy = 0.5
+)
+
+// Config parameterises the health tests.
+type Config struct {
+	// MinEntropy is the claimed min-entropy per sample, in bits (0, 8].
+	// Zero means DefaultMinEntropy.
+	MinEntropy float64
+	// OnFailure, if set, is called once when a test first fails.
+	OnFailure func(error)
+}
+
+// Source is an io.Reader that health tests everything it returns.
+// It is safe for concurrent use.
+type Source struct {
+	r         io.Reader
+	onFailure func(error)
+
+	rctCutoff int
+	aptCutoff int
+
+	mu  sync.Mutex
+	err error
+
+	// Repetition Count Test state.
+	rctLast  byte
+	rctCount int
+	rctSeen  bool
+
+	// Adaptive Proportion Test state: the first sample of the current
+	// window and how many times it has occurred so far.
+	aptFirst byte
+	aptCount int
+	aptIndex int
+}
+
+// NewSource wraps r with continuous health tests and runs the start-up tests
+// over startupSamples samples before returning.
+func NewSource(r io.Reader, cfg Config) (*Source, error) {
+	h := cfg.MinEntropy
+	if h == 0 {
+		h = DefaultMinEntropy
+	}
+	if h < 0 || h > 8 {
+		return nil, fmt.Errorf("entropy: min-entropy %v out of range (0, 8]", h)
+	}
+
+	s := &Source{
+		r:         r,
+		onFailure: cfg.OnFailure,
+		rctCutoff: RCTCutoff(h),
+		aptCutoff: APTCutoff(h),
+	}
+
+	buf := make([]byte, startupSamples)
+	if _, err := io.ReadFull(s, buf); err != nil {
+		return nil, fmt.Errorf("entropy: start-up test: %w", err)
+	}
+	return s, nil
+}
+
+// RCTCutoff is the Repetition Count Test cutoff C = 1 + ceil(-log2(α) / H)
+// (SP 800-90B section 4.4.1).
+func RCTCutoff(h float64) int {
+	return 1 + int(math.Ceil(alphaLog2/h))
+}
+
+// APTCutoff is the Adaptive Proportion Test cutoff for a window of 512
+// samples: one more than the (1-α) quantile of Binomial(W, 2^-H)
+// (SP 800-90B section 4.4.2, 1+CRITBINOM(W, 2^-H, 1-α)).
+func APTCutoff(h float64) int {
+	p := math.Exp2(-h)
+	target := math.Exp2(-alphaLog2)
+
+	// Sum the upper tail from the top down until it exceeds α; the cutoff is
+	// one past the last count whose tail probability stayed within α.
+	tail := 0.0
+	for c := aptWindow; c >= 0; c-- {
+		tail += binomPMF(aptWindow, c, p)
+		if tail > target {
+			return c + 1
+		}
+	}
+	return 1
+}
+
+func binomPMF(n, k int, p float64) float64 {
+	if p >= 1 {
+		if k == n {
+			return 1
+		}
+		return 0
+	}
+	ln, _ := math.Lgamma(float64(n + 1))
+	lk, _ := math.Lgamma(float64(k + 1))
+	lnk, _ := math.Lgamma(float64(n - k + 1))
+	return math.Exp(ln - lk - lnk + float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+}
+
+// Read fills p from the underlying source after running every sample
+// through both health tests.
+func (s *Source) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return 0, s.err
+	}
+	n, err := io.ReadFull(s.r, p)
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range p[:n] {
+		if err := s.test(b); err != nil {
+			s.fail(err)
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (s *Source) test(b byte) error {
+	// Repetition Count Test.
+	if s.rctSeen && b == s.rctLast {
+		s.rctCount++
+		if s.rctCount >= s.rctCutoff {
+			return fmt.Errorf("%w: repetition count test (sample 0x%02x repeated %d times)", ErrHealthTestFailed, b, s.rctCount)
+		}
+	} else {
+		s.rctLast, s.rctCount, s.rctSeen = b, 1, true
+	}
+
+	// Adaptive Proportion Test.
+	if s.aptIndex == 0 {
+		s.aptFirst, s.aptCount = b, 1
+	} else if b == s.aptFirst {
+		s.aptCount++
+		if s.aptCount >= s.aptCutoff {
+			return fmt.Errorf("%w: adaptive proportion test (sample 0x%02x seen %d times in %d)", ErrHealthTestFailed, b, s.aptCount, aptWindow)
+		}
+	}
+	s.aptIndex = (s.aptIndex + 1) % aptWindow
+	return nil
+}
+
+func (s *Source) fail(err error) {
+	s.err = err
+	if s.onFailure != nil {
+		s.onFailure(err)
+	}
+}
+
+// Err returns the latched health test failure, or nil while healthy.
+func (s *Source) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
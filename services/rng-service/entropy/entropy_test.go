@@ -0,0 +1,114 @@
+package entropy
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCutoffs(t *testing.T) {
+	// Cutoffs for α = 2^-20 from SP 800-90B section 4.4.1 and, for the
+	// 512-sample window, Table 2.
+	tests := []struct {
+		h        float64
+		rct, apt int
+	}{
+		{0.5, 41, 410},
+		{1, 21, 311},
+		{4, 6, 62},
+		{8, 4, 13},
+	}
+	for _, tt := range tests {
+		if got := RCTCutoff(tt.h); got != tt.rct {
+			t.Errorf("RCTCutoff(%v) = %d, want %d", tt.h, got, tt.rct)
+		}
+		if got := APTCutoff(tt.h); got != tt.apt {
+			t.Errorf("APTCutoff(%v) = %d, want %d", tt.h, got, tt.apt)
+		}
+	}
+}
+
+// healthy returns the start-up samples: every byte value in turn, which
+// passes both tests and leaves the next sample at the start of a window.
+func healthy() []byte {
+	b := make([]byte, startupSamples)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+// failAt reads tail one sample at a time after the start-up samples and
+// returns the source with the 1-based index of the sample that failed and
+// its error.
+func failAt(t *testing.T, tail []byte, onFailure func(error)) (*Source, int, error) {
+	t.Helper()
+	s, err := NewSource(bytes.NewReader(append(healthy(), tail...)), Config{OnFailure: onFailure})
+	if err != nil {
+		t.Fatalf("start-up: %v", err)
+	}
+	for i := range tail {
+		if _, err := s.Read(make([]byte, 1)); err != nil {
+			return s, i + 1, err
+		}
+	}
+	t.Fatalf("no failure in %d samples", len(tail))
+	return nil, 0, nil
+}
+
+func TestRepetitionCount(t *testing.T) {
+	// A stuck source fails on the 41st identical sample at the default
+	// min-entropy of 0.5 bits.
+	_, n, err := failAt(t, make([]byte, 1000), nil)
+	if n != 41 {
+		t.Errorf("failed at sample %d, want 41", n)
+	}
+	if !errors.Is(err, ErrHealthTestFailed) || !strings.Contains(err.Error(), "repetition count") {
+		t.Errorf("got %v, want a repetition count failure", err)
+	}
+}
+
+func TestAdaptiveProportion(t *testing.T) {
+	// Nine in ten samples are 0xAA, in runs too short for the repetition
+	// count test. The window's first sample is 0xAA, and its 410th
+	// occurrence is sample 455: 45 runs of ten hold 405, then five more.
+	var tail []byte
+	for len(tail) < aptWindow {
+		tail = append(tail, bytes.Repeat([]byte{0xAA}, 9)...)
+		tail = append(tail, 0x55)
+	}
+	_, n, err := failAt(t, tail, nil)
+	if n != 455 {
+		t.Errorf("failed at sample %d, want 455", n)
+	}
+	if !errors.Is(err, ErrHealthTestFailed) || !strings.Contains(err.Error(), "adaptive proportion") {
+		t.Errorf("got %v, want an adaptive proportion failure", err)
+	}
+}
+
+func TestFailureLatched(t *testing.T) {
+	var calls []error
+	// The stuck run is followed by healthy samples.
+	tail := append(make([]byte, 41), healthy()...)
+	s, _, first := failAt(t, tail, func(err error) { calls = append(calls, err) })
+
+	if _, err := s.Read(make([]byte, 16)); err != first {
+		t.Errorf("read after failure: %v, want the latched %v", err, first)
+	}
+	if err := s.Err(); err != first {
+		t.Errorf("Err() = %v, want %v", err, first)
+	}
+	if len(calls) != 1 || calls[0] != first {
+		t.Errorf("OnFailure called with %v, want once with %v", calls, first)
+	}
+}
+
+func TestStartupFailure(t *testing.T) {
+	if _, err := NewSource(bytes.NewReader(make([]byte, startupSamples)), Config{}); !errors.Is(err, ErrHealthTestFailed) {
+		t.Errorf("stuck source at start-up: %v, want a health test failure", err)
+	}
+	if _, err := NewSource(bytes.NewReader(healthy()), Config{MinEntropy: 9}); err == nil {
+		t.Error("min-entropy 9 accepted")
+	}
+}
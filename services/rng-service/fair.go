@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/internal/fsutil"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/fair"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// fairSession is one commit-reveal session.
+type fairSession struct {
+	mu         sync.Mutex
+	id         string
+	serverSeed []byte
+	commitment string
+	clientSeed string
+	nextNonce  uint64
+	lastUsed   time.Time
+	closed     bool // evicted; its seed has been revealed
+}
+
+func (f *fairSession) proto() *pb.FairSession {
+	return &pb.FairSession{
+		SessionId:      f.id,
+		ServerSeedHash: f.commitment,
+		ClientSeed:     f.clientSeed,
+		NextNonce:      f.nextNonce,
+	}
+}
+
+// fairState is a fairSession as persisted.
+type fairState struct {
+	ID             string    `json:"id"`
+	ServerSeed     string    `json:"server_seed"` // hex
+	ServerSeedHash string    `json:"server_seed_hash"`
+	ClientSeed     string    `json:"client_seed"`
+	NextNonce      uint64    `json:"next_nonce"`
+	LastUsed       time.Time `json:"last_used"`
+}
+
+// fairSessions holds the open provably fair sessions by id. Each session is
+// persisted as one JSON file in dir, replaced atomically on every change,
+// so committed seeds survive a restart. Sessions idle for longer than ttl,
+// and the least recently used once there are max, are evicted: their server
+// seed is revealed in the audit log and the file removed.
+type fairSessions struct {
+	dir   string
+	max   int
+	ttl   time.Duration
+	audit *audit.Log
+
+	mu       sync.Mutex
+	sessions map[string]*fairSession
+}
+
+// openFairSessions opens dir, creating it if needed, and loads the sessions
+// left by a previous run. Sessions that expired meanwhile are evicted.
+func openFairSessions(dir string, max int, ttl time.Duration, auditLog *audit.Log) (*fairSessions, error) {
+	// The files hold unrevealed server seeds.
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	f := &fairSessions{dir: dir, max: max, ttl: ttl, audit: auditLog, sessions: make(map[string]*fairSession)}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var st fairState
+		if err := json.Unmarshal(data, &st); err != nil {
+			return nil, fmt.Errorf("fair session %s: %w", path, err)
+		}
+		seed, err := hex.DecodeString(st.ServerSeed)
+		if err != nil || fair.Commit(seed) != st.ServerSeedHash {
+			return nil, fmt.Errorf("fair session %s: server seed does not match its commitment", path)
+		}
+		f.sessions[st.ID] = &fairSession{
+			id:         st.ID,
+			serverSeed: seed,
+			commitment: st.ServerSeedHash,
+			clientSeed: st.ClientSeed,
+			nextNonce:  st.NextNonce,
+			lastUsed:   st.LastUsed,
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.evictLocked(time.Now()); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// openFairSessionsFromEnv opens the fair session store configured by the
+// environment:
+//
+//	RNG_FAIR_DIR           session directory (default "rng-fair")
+//	RNG_FAIR_MAX_SESSIONS  open sessions kept before the least recently used is evicted (default 10000)
+//	RNG_FAIR_SESSION_TTL   idle time before a session is evicted (default "24h")
+func openFairSessionsFromEnv(auditLog *audit.Log) (*fairSessions, error) {
+	dir := os.Getenv("RNG_FAIR_DIR")
+	if dir == "" {
+		dir = "rng-fair"
+	}
+	max := 10000
+	if v := os.Getenv("RNG_FAIR_MAX_SESSIONS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid RNG_FAIR_MAX_SESSIONS %q", v)
+		}
+		max = n
+	}
+	ttl := 24 * time.Hour
+	if v := os.Getenv("RNG_FAIR_SESSION_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid RNG_FAIR_SESSION_TTL %q", v)
+		}
+		ttl = d
+	}
+	return openFairSessions(dir, max, ttl, auditLog)
+}
+
+func (f *fairSessions) path(id string) string {
+	return filepath.Join(f.dir, id+".json")
+}
+
+// save persists sess. Callers must hold sess.mu.
+func (f *fairSessions) save(sess *fairSession) error {
+	data, err := json.Marshal(fairState{
+		ID:             sess.id,
+		ServerSeed:     hex.EncodeToString(sess.serverSeed),
+		ServerSeedHash: sess.commitment,
+		ClientSeed:     sess.clientSeed,
+		NextNonce:      sess.nextNonce,
+		LastUsed:       sess.lastUsed,
+	})
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFileAtomic(f.path(sess.id), data)
+}
+
+func (f *fairSessions) get(id string) (*fairSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sess, ok := f.sessions[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown fair session %q", id)
+	}
+	sess.mu.Lock()
+	expired := time.Since(sess.lastUsed) > f.ttl
+	sess.mu.Unlock()
+	if expired {
+		if err := f.evict(sess); err != nil {
+			log.Printf("failed to evict fair session %s: %v", id, err)
+			return nil, status.Error(codes.Internal, "failed to close expired fair session")
+		}
+		return nil, status.Errorf(codes.NotFound, "fair session %q expired; its server seed is revealed in the audit log", id)
+	}
+	return sess, nil
+}
+
+// put persists and adds a new session, evicting expired sessions and, at
+// the cap, the least recently used.
+func (f *fairSessions) put(sess *fairSession) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.evictLocked(time.Now()); err != nil {
+		return err
+	}
+	for len(f.sessions) >= f.max {
+		var oldest *fairSession
+		var oldestUsed time.Time
+		for _, s := range f.sessions {
+			s.mu.Lock()
+			used := s.lastUsed
+			s.mu.Unlock()
+			if oldest == nil || used.Before(oldestUsed) {
+				oldest, oldestUsed = s, used
+			}
+		}
+		if err := f.evict(oldest); err != nil {
+			return err
+		}
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.lastUsed = time.Now().UTC()
+	if err := f.save(sess); err != nil {
+		return err
+	}
+	f.sessions[sess.id] = sess
+	return nil
+}
+
+// evictLocked evicts the sessions idle since before now-ttl. Callers must
+// hold f.mu.
+func (f *fairSessions) evictLocked(now time.Time) error {
+	for _, sess := range f.sessions {
+		sess.mu.Lock()
+		expired := now.Sub(sess.lastUsed) > f.ttl
+		sess.mu.Unlock()
+		if !expired {
+			continue
+		}
+		if err := f.evict(sess); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evict reveals the server seed of sess in the audit log and closes the
+// session. A session whose reveal cannot be logged stays open, so its seed
+// is never lost. Callers must hold f.mu.
+func (f *fairSessions) evict(sess *fairSession) error {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	_, err := f.audit.Append(audit.Record{
+		Kind:    "fair_reveal",
+		AuditID: sess.id,
+		Reveal: &audit.FairReveal{
+			ServerSeed:     hex.EncodeToString(sess.serverSeed),
+			ServerSeedHash: sess.commitment,
+			ClientSeed:     sess.clientSeed,
+			NoncesUsed:     sess.nextNonce,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("revealing fair session %s: %w", sess.id, err)
+	}
+	sess.closed = true
+	delete(f.sessions, sess.id)
+	if err := os.Remove(f.path(sess.id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// newServerSeed draws a fresh secret server seed from the default stream.
+func (s *rngServer) newServerSeed() ([]byte, error) {
+	st, err := s.streams.get(defaultStream)
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	seed, err := st.src.Bytes(fair.SeedSize)
+	st.mu.Unlock()
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	return seed, nil
+}
+
+func (s *rngServer) StartFairSession(ctx context.Context, req *pb.FairSessionRequest) (*pb.FairSession, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	if req.GetClientSeed() == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_seed is required")
+	}
+	seed, err := s.newServerSeed()
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.streams.get(defaultStream)
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	id, err := st.src.AuditID()
+	st.mu.Unlock()
+	if err != nil {
+		return nil, sourceError(err)
+	}
+
+	sess := &fairSession{id: id, serverSeed: seed, commitment: fair.Commit(seed), clientSeed: req.GetClientSeed()}
+	if err := s.fair.put(sess); err != nil {
+		log.Printf("failed to save fair session %s: %v", id, err)
+		return nil, status.Error(codes.Internal, "failed to save fair session")
+	}
+	return sess.proto(), nil
+}
+
+func (s *rngServer) GetFairNumbers(ctx context.Context, req *pb.FairNumbersRequest) (*pb.FairNumbersResponse, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	bounds, err := drawBounds(&pb.RNGRequest{Bounds: req.GetBounds()})
+	if err != nil {
+		return nil, err
+	}
+	sess, err := s.fair.get(req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.closed {
+		return nil, status.Errorf(codes.NotFound, "unknown fair session %q", sess.id)
+	}
+	numbers, err := fair.Draw(sess.serverSeed, sess.clientSeed, sess.nextNonce, bounds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &pb.FairNumbersResponse{
+		Numbers:        numbers,
+		Nonce:          sess.nextNonce,
+		ServerSeedHash: sess.commitment,
+		ClientSeed:     sess.clientSeed,
+	}
+	// The nonce is persisted before the numbers leave, so a restart never
+	// repeats a draw.
+	sess.nextNonce++
+	sess.lastUsed = time.Now().UTC()
+	if err := s.fair.save(sess); err != nil {
+		sess.nextNonce--
+		log.Printf("failed to save fair session %s: %v", sess.id, err)
+		return nil, status.Error(codes.Internal, "failed to save fair session")
+	}
+	return resp, nil
+}
+
+func (s *rngServer) RotateFairSeed(ctx context.Context, req *pb.RotateFairSeedRequest) (*pb.RotateFairSeedResponse, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	sess, err := s.fair.get(req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	seed, err := s.newServerSeed()
+	if err != nil {
+		return nil, err
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.closed {
+		return nil, status.Errorf(codes.NotFound, "unknown fair session %q", sess.id)
+	}
+	prevSeed, prevCommitment, prevClientSeed := sess.serverSeed, sess.commitment, sess.clientSeed
+	prevNonce, prevUsed := sess.nextNonce, sess.lastUsed
+	resp := &pb.RotateFairSeedResponse{
+		RevealedServerSeed: hex.EncodeToString(sess.serverSeed),
+		ServerSeedHash:     sess.commitment,
+		ClientSeed:         sess.clientSeed,
+		NoncesUsed:         sess.nextNonce,
+	}
+	sess.serverSeed = seed
+	sess.commitment = fair.Commit(seed)
+	if req.GetClientSeed() != "" {
+		sess.clientSeed = req.GetClientSeed()
+	}
+	sess.nextNonce = 0
+	sess.lastUsed = time.Now().UTC()
+	// The old seed is revealed only once the new one is committed durably.
+	if err := s.fair.save(sess); err != nil {
+		sess.serverSeed, sess.commitment, sess.clientSeed = prevSeed, prevCommitment, prevClientSeed
+		sess.nextNonce, sess.lastUsed = prevNonce, prevUsed
+		log.Printf("failed to save fair session %s: %v", sess.id, err)
+		return nil, status.Error(codes.Internal, "failed to save fair session")
+	}
+	resp.Next = sess.proto()
+	return resp, nil
+}
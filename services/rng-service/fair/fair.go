@@ -0,0 +1,116 @@
+// Package fair implements the provably fair commit-reveal scheme used by the
+// RNG service, and is the reference verifier for partners. It depends only on
+// the Go standard library.
+//
+// The service commits to a secret server seed by publishing
+// SHA-256(server_seed) before any draw. The player supplies a client seed.
+// Draw number nonce (0, 1, 2, ...) reads its values from the byte stream
+//
+//	HMAC-SHA256(server_seed, client_seed ":" nonce ":" cursor), cursor = 0, 1, 2, ...
+//
+// taking 8 bytes (big-endian) per candidate and rejecting candidates that
+// would introduce modulo bias. When the server seed is rotated it is revealed,
+// and every draw made under it can be recomputed with Draw or checked with
+// Verify.
+package fair
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SeedSize is the length of a server seed in bytes.
+const SeedSize = 32
+
+// Commit returns the hex-encoded SHA-256 commitment to a server seed.
+func Commit(serverSeed []byte) string {
+	sum := sha256.Sum256(serverSeed)
+	return hex.EncodeToString(sum[:])
+}
+
+// stream is the HMAC byte stream for one draw.
+type stream struct {
+	key    []byte
+	prefix string
+	cursor uint64
+	buf    []byte
+}
+
+func newStream(serverSeed []byte, clientSeed string, nonce uint64) *stream {
+	return &stream{key: serverSeed, prefix: clientSeed + ":" + strconv.FormatUint(nonce, 10) + ":"}
+}
+
+func (s *stream) uint64() uint64 {
+	if len(s.buf) < 8 {
+		m := hmac.New(sha256.New, s.key)
+		m.Write([]byte(s.prefix + strconv.FormatUint(s.cursor, 10)))
+		s.cursor++
+		s.buf = append(s.buf, m.Sum(nil)...)
+	}
+	v := binary.BigEndian.Uint64(s.buf)
+	s.buf = s.buf[8:]
+	return v
+}
+
+// intn returns a uniform value in [0, bound) by rejection sampling.
+func (s *stream) intn(bound int64) int64 {
+	n := uint64(bound)
+	limit := math.MaxUint64 - (math.MaxUint64%n+1)%n
+	for {
+		if v := s.uint64(); v <= limit {
+			return int64(v % n)
+		}
+	}
+}
+
+// Draw derives the values of draw nonce, one per bound, each uniform in
+// [0, bound). Every bound must be positive.
+func Draw(serverSeed []byte, clientSeed string, nonce uint64, bounds []int64) ([]int64, error) {
+	for i, b := range bounds {
+		if b <= 0 {
+			return nil, fmt.Errorf("fair: bounds[%d] must be positive, got %d", i, b)
+		}
+	}
+	s := newStream(serverSeed, clientSeed, nonce)
+	out := make([]int64, len(bounds))
+	for i, b := range bounds {
+		out[i] = s.intn(b)
+	}
+	return out, nil
+}
+
+// Round is one published draw, as a player or partner recorded it.
+type Round struct {
+	Nonce   uint64
+	Bounds  []int64
+	Numbers []int64
+}
+
+// Verify checks that serverSeed matches the published commitment and that
+// every round was derived from it and clientSeed.
+func Verify(serverSeed []byte, commitment, clientSeed string, rounds []Round) error {
+	if Commit(serverSeed) != commitment {
+		return errors.New("fair: server seed does not match commitment")
+	}
+	for _, r := range rounds {
+		want, err := Draw(serverSeed, clientSeed, r.Nonce, r.Bounds)
+		if err != nil {
+			return err
+		}
+		if len(want) != len(r.Numbers) {
+			return fmt.Errorf("fair: nonce %d: got %d numbers, want %d", r.Nonce, len(r.Numbers), len(want))
+		}
+		for i := range want {
+			if want[i] != r.Numbers[i] {
+				return fmt.Errorf("fair: nonce %d: numbers[%d] = %d, want %d", r.Nonce, i, r.Numbers[i], want[i])
+			}
+		}
+	}
+	return nil
+}
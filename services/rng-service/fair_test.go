@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/entropy"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/fair"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// newFairServer starts a server on the fair sessions in dir and the audit
+// log at auditPath, as after a restart.
+func newFairServer(t *testing.T, dir, auditPath string, max int, ttl time.Duration) *rngServer {
+	t.Helper()
+	src, err := entropy.NewSource(rand.Reader, entropy.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditLog, err := audit.Open(auditPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { auditLog.Close() })
+	sessions, err := openFairSessions(dir, max, ttl, auditLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	streams := &streamSet{base: drbg.Config{Entropy: rand.Reader}}
+	return &rngServer{streams: streams, entropy: src, audit: auditLog, fair: sessions}
+}
+
+// reveals returns the fair_reveal records in the audit log at path.
+func reveals(t *testing.T, path string) []audit.Record {
+	t.Helper()
+	var out []audit.Record
+	for _, rec := range auditRecords(t, path) {
+		if rec.Kind == "fair_reveal" {
+			out = append(out, rec)
+		}
+	}
+	return out
+}
+
+func TestFairSessionSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	ctx := context.Background()
+
+	s := newFairServer(t, dir, auditPath, 10, time.Hour)
+	sess, err := s.StartFairSession(ctx, &pb.FairSessionRequest{ClientSeed: "lucky7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetFairNumbers(ctx, &pb.FairNumbersRequest{SessionId: sess.GetSessionId(), Bounds: []int64{10}}); err != nil {
+		t.Fatal(err)
+	}
+
+	s = newFairServer(t, dir, auditPath, 10, time.Hour)
+	resp, err := s.GetFairNumbers(ctx, &pb.FairNumbersRequest{SessionId: sess.GetSessionId(), Bounds: []int64{10}})
+	if err != nil {
+		t.Fatalf("draw after restart: %v", err)
+	}
+	if resp.GetNonce() != 1 || resp.GetServerSeedHash() != sess.GetServerSeedHash() {
+		t.Errorf("draw after restart used nonce %d under %s, want nonce 1 under %s", resp.GetNonce(), resp.GetServerSeedHash(), sess.GetServerSeedHash())
+	}
+
+	// The seed committed before the restart is the one revealed.
+	rot, err := s.RotateFairSeed(ctx, &pb.RotateFairSeedRequest{SessionId: sess.GetSessionId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, err := hex.DecodeString(rot.GetRevealedServerSeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fair.Commit(seed) != sess.GetServerSeedHash() || rot.GetNoncesUsed() != 2 {
+		t.Errorf("revealed seed does not match the commitment or %d nonces used, want 2", rot.GetNoncesUsed())
+	}
+}
+
+func TestFairSessionEviction(t *testing.T) {
+	dir := t.TempDir()
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	ctx := context.Background()
+
+	s := newFairServer(t, dir, auditPath, 2, time.Hour)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		sess, err := s.StartFairSession(ctx, &pb.FairSessionRequest{ClientSeed: "seed"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, sess.GetSessionId())
+	}
+
+	// The third session evicted the least recently used, the first.
+	if _, err := s.GetFairNumbers(ctx, &pb.FairNumbersRequest{SessionId: ids[0], Bounds: []int64{10}}); status.Code(err) != codes.NotFound {
+		t.Errorf("draw on evicted session: %v, want NotFound", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ids[0]+".json")); !os.IsNotExist(err) {
+		t.Errorf("evicted session file still present: %v", err)
+	}
+	got := reveals(t, auditPath)
+	if len(got) != 1 || got[0].AuditID != ids[0] {
+		t.Fatalf("reveals %+v, want one for %s", got, ids[0])
+	}
+	seed, err := hex.DecodeString(got[0].Reveal.ServerSeed)
+	if err != nil || fair.Commit(seed) != got[0].Reveal.ServerSeedHash {
+		t.Errorf("revealed seed does not match its commitment")
+	}
+
+	// Sessions idle past the TTL are revealed when the store reopens.
+	s = newFairServer(t, dir, auditPath, 2, time.Nanosecond)
+	if n := len(reveals(t, auditPath)); n != 3 {
+		t.Errorf("%d reveals after expiry, want 3", n)
+	}
+	if _, err := s.fair.get(ids[2]); status.Code(err) != codes.NotFound {
+		t.Errorf("expired session: %v, want NotFound", err)
+	}
+	f, err := os.Open(auditPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := audit.Verify(f); err != nil {
+		t.Errorf("audit log: %v", err)
+	}
+}
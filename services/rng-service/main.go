@@ -2,52 +2,328 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"log"
-	"math/rand"
 	"net"
+	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/entropy"
 	// Import the local protobuf package
-	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
 )
 
+// defaultBound is the exclusive upper bound for count-only requests (values 0..99).
+const defaultBound = 100
+
+// maxDraws caps the draws in one GetNumbers or GetWeighted request.
+const maxDraws = 1 << 16
+
 type rngServer struct {
 	pb.UnimplementedRNGServiceServer
+	streams *streamSet
+	entropy *entropy.Source
+	audit   *audit.Log
+	fair    *fairSessions
+}
+
+// checkHealth refuses service once the entropy source has failed a
+// continuous health test.
+func (s *rngServer) checkHealth() error {
+	if err := s.entropy.Err(); err != nil {
+		return status.Errorf(codes.Unavailable, "rng entropy source unhealthy: %v", err)
+	}
+	return nil
 }
 
 func (s *rngServer) GetNumbers(ctx context.Context, req *pb.RNGRequest) (*pb.RNGResponse, error) {
-	// Use math/rand (v1) for simplicity and compatibility
-	rand.Seed(time.Now().UnixNano())
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	bounds, err := drawBounds(req)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.streams.get(req.GetStream())
+	if err != nil {
+		return nil, err
+	}
+
+	meta := drawMeta{requestID: req.GetRequestId(), caller: req.GetCaller(), roundID: req.GetRoundId()}
+	numbers, auditID, err := s.drawNumbers(st, "numbers", meta, bounds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RNGResponse{Numbers: numbers, AuditId: auditID}, nil
+}
+
+// drawMeta is the caller-supplied context recorded with a draw.
+type drawMeta struct {
+	requestID string
+	caller    string
+	roundID   string
+}
+
+// drawNumbers draws one value per bound from st and records the draw in the
+// audit log. It returns the values and the draw's audit id.
+func (s *rngServer) drawNumbers(st *rngStream, kind string, meta drawMeta, bounds []int64) ([]int64, string, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	numbers, auditID, err := st.drawLocked(bounds)
+	if err != nil {
+		return nil, "", sourceError(err)
+	}
+	// No numbers leave the service without an audit record.
+	if err := s.record(st, audit.Record{Kind: kind, Bounds: bounds, Outputs: numbers}, meta, auditID); err != nil {
+		return nil, "", err
+	}
+	return numbers, auditID, nil
+}
+
+// drawLocked draws one value per bound and an audit id for the draw.
+// Callers must hold st.mu and record the result before releasing it.
+func (st *rngStream) drawLocked(bounds []int64) ([]int64, string, error) {
+	numbers := make([]int64, len(bounds))
+	for i, bound := range bounds {
+		n, err := st.src.Intn(bound)
+		if err != nil {
+			return nil, "", err
+		}
+		numbers[i] = n
+	}
+
+	// The audit id is returned in place of a seed; the DRBG state itself is
+	// never exposed.
+	auditID, err := st.src.AuditID()
+	if err != nil {
+		return nil, "", err
+	}
+	return numbers, auditID, nil
+}
+
+// record completes rec with the request context and the stream's DRBG
+// counter and appends it to the audit log. Callers must hold st.mu.
+func (s *rngServer) record(st *rngStream, rec audit.Record, meta drawMeta, auditID string) error {
+	rec.RequestID = meta.requestID
+	if rec.RequestID == "" {
+		rec.RequestID = auditID
+	}
+	rec.AuditID = auditID
+	rec.Caller = meta.caller
+	rec.RoundID = meta.roundID
+	rec.Stream = st.name
+	rec.DRBGCounter = st.gen.Info().Generated
+
+	if _, err := s.audit.Append(rec); err != nil {
+		log.Printf("audit log append failed: %v", err)
+		return status.Error(codes.Internal, "audit log unavailable")
+	}
+	return nil
+}
+
+// sourceError maps a failure reading a DRBG to a gRPC status.
+func sourceError(err error) error {
+	log.Printf("RNG source failure: %v", err)
+	if errors.Is(err, entropy.ErrHealthTestFailed) {
+		return status.Errorf(codes.Unavailable, "rng entropy source unhealthy: %v", err)
+	}
+	return status.Error(codes.Internal, "rng source failure")
+}
+
+// drawBounds returns the exclusive upper bound for each requested draw.
+// Explicit bounds take precedence; otherwise count draws use defaultBound.
+func drawBounds(req *pb.RNGRequest) ([]int64, error) {
+	if len(req.GetBounds()) > maxDraws {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d bounds per request", maxDraws)
+	}
+	if len(req.GetBounds()) > 0 {
+		for i, b := range req.GetBounds() {
+			if b <= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "bounds[%d] must be positive, got %d", i, b)
+			}
+		}
+		return req.GetBounds(), nil
+	}
 
 	count := int(req.GetCount())
-	if count <= 0 { count = 1 }
+	if count > maxDraws {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be at most %d", maxDraws)
+	}
+	if count <= 0 {
+		count = 1
+	}
+	bounds := make([]int64, count)
+	for i := range bounds {
+		bounds[i] = defaultBound
+	}
+	return bounds, nil
+}
 
-	numbers := make([]int32, count)
-	for i := 0; i < count; i++ {
-		// Generate random number (0 to 100 for simplicity)
-		numbers[i] = int32(rand.Intn(100))
+// drbgConfigFromEnv builds the DRBG configuration from the environment:
+//
+//	RNG_DRBG                   hmac-sha256 (default) or ctr-aes256
+//	RNG_RESEED_INTERVAL        generate requests between reseeds (default 2^48)
+//	RNG_PREDICTION_RESISTANCE  "true" to reseed before every generate request
+//
+// These apply to every RNG stream; see streamIntervalsFromEnv for per-stream
+// reseed intervals. The entropy source is set separately by main.
+func drbgConfigFromEnv() (drbg.Config, error) {
+	cfg := drbg.Config{
+		Algorithm: os.Getenv("RNG_DRBG"),
 	}
+	if v := os.Getenv("RNG_RESEED_INTERVAL"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.ReseedInterval = n
+	}
+	if v := os.Getenv("RNG_PREDICTION_RESISTANCE"); v != "" {
+		pr, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.PredictionResistance = pr
+	}
+	return cfg, nil
+}
 
-	// FIX: Use 'Numbers' (Capitalized) matching the generated code
-	return &pb.RNGResponse{Numbers: numbers, Seed: time.Now().UnixNano()}, nil
+// entropyConfigFromEnv builds the health test configuration from the environment:
+//
+//	RNG_HEALTH_MIN_ENTROPY  claimed min-entropy per byte sample (default 0.5)
+func entropyConfigFromEnv() (entropy.Config, error) {
+	var cfg entropy.Config
+	if v := os.Getenv("RNG_HEALTH_MIN_ENTROPY"); v != "" {
+		h, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.MinEntropy = h
+	}
+	return cfg, nil
+}
+
+// entropyFailed returns the entropy source's OnFailure hook: it reports
+// every service on healthSrv as NOT_SERVING, as draws are refused from then on.
+func entropyFailed(healthSrv *health.Server) func(error) {
+	return func(err error) {
+		log.Printf("ENTROPY HEALTH TEST FAILURE, refusing draws: %v", err)
+		healthSrv.Shutdown()
+	}
 }
 
 func main() {
+	if err := drbg.SelfTest(); err != nil {
+		log.Fatalf("DRBG self test failed: %v", err)
+	}
+
+	healthSrv := health.NewServer()
+	ecfg, err := entropyConfigFromEnv()
+	if err != nil {
+		log.Fatalf("invalid health test configuration: %v", err)
+	}
+	ecfg.OnFailure = entropyFailed(healthSrv)
+	// Raw OS entropy feeds the DRBG only through the continuous health tests.
+	src, err := entropy.NewSource(rand.Reader, ecfg)
+	if err != nil {
+		log.Fatalf("entropy source failed start-up health tests: %v", err)
+	}
+
+	cfg, err := drbgConfigFromEnv()
+	if err != nil {
+		log.Fatalf("invalid DRBG configuration: %v", err)
+	}
+	cfg.Entropy = src
+	intervals, err := streamIntervalsFromEnv()
+	if err != nil {
+		log.Fatalf("invalid stream configuration: %v", err)
+	}
+	streams := &streamSet{base: cfg, intervals: intervals}
+	// Instantiate the default and configured streams up front so a bad
+	// configuration fails at startup.
+	if _, err := streams.get(defaultStream); err != nil {
+		log.Fatalf("failed to instantiate DRBG: %v", err)
+	}
+	for name := range intervals {
+		if _, err := streams.get(name); err != nil {
+			log.Fatalf("failed to instantiate DRBG for stream %q: %v", name, err)
+		}
+	}
+
+	auditPath := os.Getenv("RNG_AUDIT_LOG")
+	if auditPath == "" {
+		auditPath = "rng-audit.log"
+	}
+	auditLog, err := audit.Open(auditPath)
+	if err != nil {
+		log.Fatalf("failed to open audit log: %v", err)
+	}
+	defer auditLog.Close()
+	// The head is the anchor auditverify -records/-head checks a later copy
+	// of the log against; truncation is otherwise undetectable.
+	records, head := auditLog.Head()
+	log.Printf("Audit log %s opened: %d records, head hash %s", auditPath, records, head)
+
+	fairSess, err := openFairSessionsFromEnv(auditLog)
+	if err != nil {
+		log.Fatalf("failed to open fair sessions: %v", err)
+	}
+
+	// Background cycling and metrics:
+	//
+	//	RNG_CYCLE_INTERVAL  mean delay between cycles, e.g. "10ms" (default); "0" disables
+	//	RNG_METRICS_ADDR    metrics listen address (default ":9090")
+	cycleInterval := 10 * time.Millisecond
+	if v := os.Getenv("RNG_CYCLE_INTERVAL"); v != "" {
+		if cycleInterval, err = time.ParseDuration(v); err != nil || cycleInterval < 0 {
+			log.Fatalf("invalid RNG_CYCLE_INTERVAL %q", v)
+		}
+	}
+	metrics := &metricsHandler{streams: streams}
+	if cycleInterval > 0 {
+		c := &cycler{streams: streams, interval: cycleInterval}
+		go c.run(context.Background())
+		metrics.cycler = c
+		log.Printf("Background cycling every %s on average", cycleInterval)
+	}
+	metricsAddr := os.Getenv("RNG_METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9090"
+	}
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics)
+		log.Printf("Metrics listening on %s", metricsAddr)
+		if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+			log.Fatalf("failed to serve metrics: %v", err)
+		}
+	}()
+
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	s := grpc.NewServer()
-	pb.RegisterRNGServiceServer(s, &rngServer{})
+	pb.RegisterRNGServiceServer(s, &rngServer{streams: streams, entropy: src, audit: auditLog, fair: fairSess})
+	healthpb.RegisterHealthServer(s, healthSrv)
 	reflection.Register(s)
 
 	log.Printf("RNG Service listening on :50051")
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
-}
\ No newline at end of file
+}
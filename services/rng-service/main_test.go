@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/entropy"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// newTestServer returns a server drawing from crypto/rand, with its audit
+// log at the returned path.
+func newTestServer(t *testing.T) (*rngServer, string) {
+	t.Helper()
+	src, err := entropy.NewSource(rand.Reader, entropy.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := audit.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { auditLog.Close() })
+	streams := &streamSet{base: drbg.Config{Entropy: rand.Reader}}
+	return &rngServer{streams: streams, entropy: src, audit: auditLog}, path
+}
+
+// auditRecords returns the records in the audit log at path.
+func auditRecords(t *testing.T, path string) []audit.Record {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []audit.Record
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var rec audit.Record
+		if err := json.Unmarshal(line, &rec); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+func TestDrawBounds(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.RNGRequest
+		n    int
+		code codes.Code
+	}{
+		{"default count", &pb.RNGRequest{}, 1, codes.OK},
+		{"count", &pb.RNGRequest{Count: 5}, 5, codes.OK},
+		{"max count", &pb.RNGRequest{Count: maxDraws}, maxDraws, codes.OK},
+		{"count over cap", &pb.RNGRequest{Count: maxDraws + 1}, 0, codes.InvalidArgument},
+		{"bounds", &pb.RNGRequest{Bounds: []int64{6, 6}}, 2, codes.OK},
+		{"non-positive bound", &pb.RNGRequest{Bounds: []int64{6, 0}}, 0, codes.InvalidArgument},
+		{"bounds over cap", &pb.RNGRequest{Bounds: make([]int64, maxDraws+1)}, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bounds, err := drawBounds(tt.req)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got %v, want %v", err, tt.code)
+			}
+			if len(bounds) != tt.n {
+				t.Errorf("got %d bounds, want %d", len(bounds), tt.n)
+			}
+		})
+	}
+}
+
+func TestEntropyFailureUnavailable(t *testing.T) {
+	// The source passes its start-up tests and seeds the stream, then sticks.
+	raw := make([]byte, 2048)
+	for i := range raw[:1280] {
+		raw[i] = byte(i)
+	}
+	healthSrv := health.NewServer()
+	src, err := entropy.NewSource(bytes.NewReader(raw), entropy.Config{OnFailure: entropyFailed(healthSrv)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditLog, err := audit.Open(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer auditLog.Close()
+	// Prediction resistance reseeds on every draw, reading the source.
+	streams := &streamSet{base: drbg.Config{PredictionResistance: true, Entropy: src}}
+	s := &rngServer{streams: streams, entropy: src, audit: auditLog}
+
+	ctx := context.Background()
+	serving := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := healthSrv.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.GetStatus()
+	}
+	if got := serving(); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("health %v before failure, want SERVING", got)
+	}
+
+	req := &pb.RNGRequest{Bounds: []int64{100}}
+	for i := 0; err == nil && i < 50; i++ {
+		_, err = s.GetNumbers(ctx, req)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("draw as the source sticks: %v, want Unavailable", err)
+	}
+	if got := serving(); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("health %v after failure, want NOT_SERVING", got)
+	}
+	if _, err := s.GetNumbers(ctx, req); status.Code(err) != codes.Unavailable {
+		t.Errorf("draw after failure: %v, want Unavailable", err)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// metricsHandler serves RNG metrics in the Prometheus text format.
+type metricsHandler struct {
+	streams *streamSet
+	cycler  *cycler // nil when background cycling is disabled
+}
+
+func (h *metricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	if h.cycler != nil {
+		total, rate, errors := h.cycler.stats()
+		fmt.Fprintf(w, "# HELP rng_cycle_interval_seconds Mean delay between background cycles.\n")
+		fmt.Fprintf(w, "# TYPE rng_cycle_interval_seconds gauge\n")
+		fmt.Fprintf(w, "rng_cycle_interval_seconds %g\n", h.cycler.interval.Seconds())
+		fmt.Fprintf(w, "# HELP rng_cycle_rate Background cycles per second across all streams, over the last %s.\n", rateWindow)
+		fmt.Fprintf(w, "# TYPE rng_cycle_rate gauge\n")
+		fmt.Fprintf(w, "rng_cycle_rate %g\n", rate)
+		fmt.Fprintf(w, "# HELP rng_cycles_total Background cycles across all streams.\n")
+		fmt.Fprintf(w, "# TYPE rng_cycles_total counter\n")
+		fmt.Fprintf(w, "rng_cycles_total %d\n", total)
+		fmt.Fprintf(w, "# HELP rng_cycle_errors_total Failed background cycles.\n")
+		fmt.Fprintf(w, "# TYPE rng_cycle_errors_total counter\n")
+		fmt.Fprintf(w, "rng_cycle_errors_total %d\n", errors)
+	}
+
+	streams := h.streams.all()
+	sort.Slice(streams, func(i, j int) bool { return streams[i].name < streams[j].name })
+
+	fmt.Fprintf(w, "# HELP rng_stream_cycles_total Background cycles per stream.\n")
+	fmt.Fprintf(w, "# TYPE rng_stream_cycles_total counter\n")
+	for _, st := range streams {
+		st.mu.Lock()
+		cycles := st.cycles
+		st.mu.Unlock()
+		fmt.Fprintf(w, "rng_stream_cycles_total{stream=%q} %d\n", st.name, cycles)
+	}
+	fmt.Fprintf(w, "# HELP rng_stream_generate_total DRBG generate requests per stream.\n")
+	fmt.Fprintf(w, "# TYPE rng_stream_generate_total counter\n")
+	for _, st := range streams {
+		fmt.Fprintf(w, "rng_stream_generate_total{stream=%q} %d\n", st.name, st.gen.Info().Generated)
+	}
+	fmt.Fprintf(w, "# HELP rng_stream_reseeds_total DRBG reseeds per stream.\n")
+	fmt.Fprintf(w, "# TYPE rng_stream_reseeds_total counter\n")
+	for _, st := range streams {
+		fmt.Fprintf(w, "rng_stream_reseeds_total{stream=%q} %d\n", st.name, st.gen.Info().Reseeds)
+	}
+}
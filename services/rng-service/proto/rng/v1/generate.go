@@ -0,0 +1,4 @@
+// Package rngv1 holds the generated code for the rng.v1 gRPC API.
+package rngv1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative rng/v1/rng.proto
@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: rng/v1/rng.proto
+
+package rngv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RNGRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of draws in [0, 100). Ignored when bounds is set.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// One draw per entry, each uniform in [0, bound). Bounds must be positive,
+	// e.g. the length of each reel strip.
+	Bounds []int64 `protobuf:"varint,2,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`
+	// Audit trail context, recorded with the draw.
+	// Caller's id for this request; defaults to the response's audit id.
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Name of the calling service, e.g. "game-engine-service".
+	Caller string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Game round the draw belongs to, if any.
+	RoundId string `protobuf:"bytes,5,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Isolated RNG stream to draw from, normally the game_code (or an
+	// operator id). Each stream has its own DRBG and reseed schedule.
+	// Empty selects the default stream.
+	Stream        string `protobuf:"bytes,6,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RNGRequest) Reset() {
+	*x = RNGRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RNGRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RNGRequest) ProtoMessage() {}
+
+func (x *RNGRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RNGRequest.ProtoReflect.Descriptor instead.
+func (*RNGRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RNGRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RNGRequest) GetBounds() []int64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *RNGRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RNGRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *RNGRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *RNGRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type RNGResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Note: 'numbers' (lowercase) in proto becomes 'Numbers' (Uppercase) in Go
+	Numbers []int64 `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	// Audit identifier for the draw. The generator state is never exposed.
+	AuditId       string `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RNGResponse) Reset() {
+	*x = RNGResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RNGResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RNGResponse) ProtoMessage() {}
+
+func (x *RNGResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RNGResponse.ProtoReflect.Descriptor instead.
+func (*RNGResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RNGResponse) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *RNGResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type StreamNumbersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One frame of draws, each uniform in [0, bound), e.g. one per reel.
+	Bounds []int64 `protobuf:"varint,1,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`
+	// Number of frames to deliver. 0 streams until the client cancels.
+	Frames uint64 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
+	// Frames packed into each message (default 1). frames_per_message times
+	// len(bounds) must not exceed 65536.
+	FramesPerMessage uint32 `protobuf:"varint,3,opt,name=frames_per_message,json=framesPerMessage,proto3" json:"frames_per_message,omitempty"`
+	// Audit trail context and RNG stream, as in RNGRequest.
+	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Caller        string `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	RoundId       string `protobuf:"bytes,6,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Stream        string `protobuf:"bytes,7,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNumbersRequest) Reset() {
+	*x = StreamNumbersRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNumbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNumbersRequest) ProtoMessage() {}
+
+func (x *StreamNumbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNumbersRequest.ProtoReflect.Descriptor instead.
+func (*StreamNumbersRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamNumbersRequest) GetBounds() []int64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *StreamNumbersRequest) GetFrames() uint64 {
+	if x != nil {
+		return x.Frames
+	}
+	return 0
+}
+
+func (x *StreamNumbersRequest) GetFramesPerMessage() uint32 {
+	if x != nil {
+		return x.FramesPerMessage
+	}
+	return 0
+}
+
+func (x *StreamNumbersRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StreamNumbersRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *StreamNumbersRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *StreamNumbersRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type StreamNumbersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Frames in order, len(bounds) values each.
+	Numbers []int64 `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	// Index of the first frame in this message.
+	FirstFrame uint64 `protobuf:"varint,2,opt,name=first_frame,json=firstFrame,proto3" json:"first_frame,omitempty"`
+	// Audit identifier of this message's draw.
+	AuditId       string `protobuf:"bytes,3,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNumbersResponse) Reset() {
+	*x = StreamNumbersResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNumbersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNumbersResponse) ProtoMessage() {}
+
+func (x *StreamNumbersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNumbersResponse.ProtoReflect.Descriptor instead.
+func (*StreamNumbersResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamNumbersResponse) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *StreamNumbersResponse) GetFirstFrame() uint64 {
+	if x != nil {
+		return x.FirstFrame
+	}
+	return 0
+}
+
+func (x *StreamNumbersResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type WeightTable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Non-negative integer weights; at least one must be positive.
+	Weights       []int64 `protobuf:"varint,1,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightTable) Reset() {
+	*x = WeightTable{}
+	mi := &file_rng_v1_rng_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightTable) ProtoMessage() {}
+
+func (x *WeightTable) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightTable.ProtoReflect.Descriptor instead.
+func (*WeightTable) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WeightTable) GetWeights() []int64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+type WeightedRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tables []*WeightTable         `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	// Audit trail context and RNG stream, as in RNGRequest.
+	RequestId     string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Caller        string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	RoundId       string `protobuf:"bytes,4,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Stream        string `protobuf:"bytes,5,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedRequest) Reset() {
+	*x = WeightedRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedRequest) ProtoMessage() {}
+
+func (x *WeightedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedRequest.ProtoReflect.Descriptor instead.
+func (*WeightedRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WeightedRequest) GetTables() []*WeightTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *WeightedRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WeightedRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *WeightedRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *WeightedRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type WeightedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chosen index into each table, in request order.
+	Indices       []int64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	AuditId       string  `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedResponse) Reset() {
+	*x = WeightedResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedResponse) ProtoMessage() {}
+
+func (x *WeightedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedResponse.ProtoReflect.Descriptor instead.
+func (*WeightedResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WeightedResponse) GetIndices() []int64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *WeightedResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type ShuffleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of items, e.g. 52 for a deck or 416 for an 8-deck shoe.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Audit trail context and RNG stream, as in RNGRequest.
+	RequestId     string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Caller        string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	RoundId       string `protobuf:"bytes,4,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Stream        string `protobuf:"bytes,5,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleRequest) Reset() {
+	*x = ShuffleRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleRequest) ProtoMessage() {}
+
+func (x *ShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleRequest.ProtoReflect.Descriptor instead.
+func (*ShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShuffleRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ShuffleRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ShuffleRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ShuffleRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ShuffleRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type ShuffleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permutation of 0..count-1: permutation[i] is the item at position i.
+	Permutation   []int64 `protobuf:"varint,1,rep,packed,name=permutation,proto3" json:"permutation,omitempty"`
+	AuditId       string  `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleResponse) Reset() {
+	*x = ShuffleResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleResponse) ProtoMessage() {}
+
+func (x *ShuffleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleResponse.ProtoReflect.Descriptor instead.
+func (*ShuffleResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShuffleResponse) GetPermutation() []int64 {
+	if x != nil {
+		return x.Permutation
+	}
+	return nil
+}
+
+func (x *ShuffleResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type SampleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values are drawn from 1..population, e.g. 80 for keno.
+	Population int64 `protobuf:"varint,1,opt,name=population,proto3" json:"population,omitempty"`
+	// Number of unique values to draw, at most population.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Audit trail context and RNG stream, as in RNGRequest.
+	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Caller        string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	RoundId       string `protobuf:"bytes,5,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Stream        string `protobuf:"bytes,6,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleRequest) Reset() {
+	*x = SampleRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleRequest) ProtoMessage() {}
+
+func (x *SampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleRequest.ProtoReflect.Descriptor instead.
+func (*SampleRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SampleRequest) GetPopulation() int64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+func (x *SampleRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SampleRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SampleRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *SampleRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *SampleRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type SampleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique values in draw order.
+	Values        []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	AuditId       string  `protobuf:"bytes,2,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleResponse) Reset() {
+	*x = SampleResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleResponse) ProtoMessage() {}
+
+func (x *SampleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleResponse.ProtoReflect.Descriptor instead.
+func (*SampleResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SampleResponse) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SampleResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+type FairSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player-supplied seed mixed into every draw. Must not be empty.
+	ClientSeed    string `protobuf:"bytes,1,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FairSessionRequest) Reset() {
+	*x = FairSessionRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairSessionRequest) ProtoMessage() {}
+
+func (x *FairSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairSessionRequest.ProtoReflect.Descriptor instead.
+func (*FairSessionRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FairSessionRequest) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+type FairSession struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Hex SHA-256 of the secret server seed, published before any draw.
+	ServerSeedHash string `protobuf:"bytes,2,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`
+	ClientSeed     string `protobuf:"bytes,3,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	// Nonce the next draw will use.
+	NextNonce     uint64 `protobuf:"varint,4,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FairSession) Reset() {
+	*x = FairSession{}
+	mi := &file_rng_v1_rng_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairSession) ProtoMessage() {}
+
+func (x *FairSession) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairSession.ProtoReflect.Descriptor instead.
+func (*FairSession) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FairSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FairSession) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *FairSession) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *FairSession) GetNextNonce() uint64 {
+	if x != nil {
+		return x.NextNonce
+	}
+	return 0
+}
+
+type FairNumbersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// One draw per entry, each uniform in [0, bound).
+	Bounds        []int64 `protobuf:"varint,2,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FairNumbersRequest) Reset() {
+	*x = FairNumbersRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairNumbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairNumbersRequest) ProtoMessage() {}
+
+func (x *FairNumbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairNumbersRequest.ProtoReflect.Descriptor instead.
+func (*FairNumbersRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FairNumbersRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FairNumbersRequest) GetBounds() []int64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+type FairNumbersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Numbers        []int64                `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	Nonce          uint64                 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ServerSeedHash string                 `protobuf:"bytes,3,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`
+	ClientSeed     string                 `protobuf:"bytes,4,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FairNumbersResponse) Reset() {
+	*x = FairNumbersResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairNumbersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairNumbersResponse) ProtoMessage() {}
+
+func (x *FairNumbersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairNumbersResponse.ProtoReflect.Descriptor instead.
+func (*FairNumbersResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FairNumbersResponse) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *FairNumbersResponse) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *FairNumbersResponse) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *FairNumbersResponse) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+type RotateFairSeedRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Client seed for the new server seed. Empty keeps the current one.
+	ClientSeed    string `protobuf:"bytes,2,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateFairSeedRequest) Reset() {
+	*x = RotateFairSeedRequest{}
+	mi := &file_rng_v1_rng_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateFairSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateFairSeedRequest) ProtoMessage() {}
+
+func (x *RotateFairSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateFairSeedRequest.ProtoReflect.Descriptor instead.
+func (*RotateFairSeedRequest) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RotateFairSeedRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RotateFairSeedRequest) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+type RotateFairSeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hex server seed now retired, with the values needed to verify every
+	// draw made under it (nonces 0 .. nonces_used-1).
+	RevealedServerSeed string `protobuf:"bytes,1,opt,name=revealed_server_seed,json=revealedServerSeed,proto3" json:"revealed_server_seed,omitempty"`
+	ServerSeedHash     string `protobuf:"bytes,2,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`
+	ClientSeed         string `protobuf:"bytes,3,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	NoncesUsed         uint64 `protobuf:"varint,4,opt,name=nonces_used,json=noncesUsed,proto3" json:"nonces_used,omitempty"`
+	// The session's new commitment.
+	Next          *FairSession `protobuf:"bytes,5,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateFairSeedResponse) Reset() {
+	*x = RotateFairSeedResponse{}
+	mi := &file_rng_v1_rng_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateFairSeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateFairSeedResponse) ProtoMessage() {}
+
+func (x *RotateFairSeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rng_v1_rng_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateFairSeedResponse.ProtoReflect.Descriptor instead.
+func (*RotateFairSeedResponse) Descriptor() ([]byte, []int) {
+	return file_rng_v1_rng_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RotateFairSeedResponse) GetRevealedServerSeed() string {
+	if x != nil {
+		return x.RevealedServerSeed
+	}
+	return ""
+}
+
+func (x *RotateFairSeedResponse) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *RotateFairSeedResponse) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *RotateFairSeedResponse) GetNoncesUsed() uint64 {
+	if x != nil {
+		return x.NoncesUsed
+	}
+	return 0
+}
+
+func (x *RotateFairSeedResponse) GetNext() *FairSession {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+var File_rng_v1_rng_proto protoreflect.FileDescriptor
+
+const file_rng_v1_rng_proto_rawDesc = "" +
+	"\n" +
+	"\x10rng/v1/rng.proto\x12\x06rng.v1\"\xa4\x01\n" +
+	"\n" +
+	"RNGRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06bounds\x18\x02 \x03(\x03R\x06bounds\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06caller\x18\x04 \x01(\tR\x06caller\x12\x19\n" +
+	"\bround_id\x18\x05 \x01(\tR\aroundId\x12\x16\n" +
+	"\x06stream\x18\x06 \x01(\tR\x06stream\"B\n" +
+	"\vRNGResponse\x12\x18\n" +
+	"\anumbers\x18\x01 \x03(\x03R\anumbers\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\"\xde\x01\n" +
+	"\x14StreamNumbersRequest\x12\x16\n" +
+	"\x06bounds\x18\x01 \x03(\x03R\x06bounds\x12\x16\n" +
+	"\x06frames\x18\x02 \x01(\x04R\x06frames\x12,\n" +
+	"\x12frames_per_message\x18\x03 \x01(\rR\x10framesPerMessage\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06caller\x18\x05 \x01(\tR\x06caller\x12\x19\n" +
+	"\bround_id\x18\x06 \x01(\tR\aroundId\x12\x16\n" +
+	"\x06stream\x18\a \x01(\tR\x06stream\"m\n" +
+	"\x15StreamNumbersResponse\x12\x18\n" +
+	"\anumbers\x18\x01 \x03(\x03R\anumbers\x12\x1f\n" +
+	"\vfirst_frame\x18\x02 \x01(\x04R\n" +
+	"firstFrame\x12\x19\n" +
+	"\baudit_id\x18\x03 \x01(\tR\aauditId\"'\n" +
+	"\vWeightTable\x12\x18\n" +
+	"\aweights\x18\x01 \x03(\x03R\aweights\"\xa8\x01\n" +
+	"\x0fWeightedRequest\x12+\n" +
+	"\x06tables\x18\x01 \x03(\v2\x13.rng.v1.WeightTableR\x06tables\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06caller\x18\x03 \x01(\tR\x06caller\x12\x19\n" +
+	"\bround_id\x18\x04 \x01(\tR\aroundId\x12\x16\n" +
+	"\x06stream\x18\x05 \x01(\tR\x06stream\"G\n" +
+	"\x10WeightedResponse\x12\x18\n" +
+	"\aindices\x18\x01 \x03(\x03R\aindices\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\"\x90\x01\n" +
+	"\x0eShuffleRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06caller\x18\x03 \x01(\tR\x06caller\x12\x19\n" +
+	"\bround_id\x18\x04 \x01(\tR\aroundId\x12\x16\n" +
+	"\x06stream\x18\x05 \x01(\tR\x06stream\"N\n" +
+	"\x0fShuffleResponse\x12 \n" +
+	"\vpermutation\x18\x01 \x03(\x03R\vpermutation\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\"\xaf\x01\n" +
+	"\rSampleRequest\x12\x1e\n" +
+	"\n" +
+	"population\x18\x01 \x01(\x03R\n" +
+	"population\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06caller\x18\x04 \x01(\tR\x06caller\x12\x19\n" +
+	"\bround_id\x18\x05 \x01(\tR\aroundId\x12\x16\n" +
+	"\x06stream\x18\x06 \x01(\tR\x06stream\"C\n" +
+	"\x0eSampleResponse\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x03R\x06values\x12\x19\n" +
+	"\baudit_id\x18\x02 \x01(\tR\aauditId\"5\n" +
+	"\x12FairSessionRequest\x12\x1f\n" +
+	"\vclient_seed\x18\x01 \x01(\tR\n" +
+	"clientSeed\"\x96\x01\n" +
+	"\vFairSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12(\n" +
+	"\x10server_seed_hash\x18\x02 \x01(\tR\x0eserverSeedHash\x12\x1f\n" +
+	"\vclient_seed\x18\x03 \x01(\tR\n" +
+	"clientSeed\x12\x1d\n" +
+	"\n" +
+	"next_nonce\x18\x04 \x01(\x04R\tnextNonce\"K\n" +
+	"\x12FairNumbersRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06bounds\x18\x02 \x03(\x03R\x06bounds\"\x90\x01\n" +
+	"\x13FairNumbersResponse\x12\x18\n" +
+	"\anumbers\x18\x01 \x03(\x03R\anumbers\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\x04R\x05nonce\x12(\n" +
+	"\x10server_seed_hash\x18\x03 \x01(\tR\x0eserverSeedHash\x12\x1f\n" +
+	"\vclient_seed\x18\x04 \x01(\tR\n" +
+	"clientSeed\"W\n" +
+	"\x15RotateFairSeedRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vclient_seed\x18\x02 \x01(\tR\n" +
+	"clientSeed\"\xdf\x01\n" +
+	"\x16RotateFairSeedResponse\x120\n" +
+	"\x14revealed_server_seed\x18\x01 \x01(\tR\x12revealedServerSeed\x12(\n" +
+	"\x10server_seed_hash\x18\x02 \x01(\tR\x0eserverSeedHash\x12\x1f\n" +
+	"\vclient_seed\x18\x03 \x01(\tR\n" +
+	"clientSeed\x12\x1f\n" +
+	"\vnonces_used\x18\x04 \x01(\x04R\n" +
+	"noncesUsed\x12'\n" +
+	"\x04next\x18\x05 \x01(\v2\x13.rng.v1.FairSessionR\x04next2\xbb\x04\n" +
+	"\n" +
+	"RNGService\x127\n" +
+	"\n" +
+	"GetNumbers\x12\x12.rng.v1.RNGRequest\x1a\x13.rng.v1.RNGResponse\"\x00\x12P\n" +
+	"\rStreamNumbers\x12\x1c.rng.v1.StreamNumbersRequest\x1a\x1d.rng.v1.StreamNumbersResponse\"\x000\x01\x12B\n" +
+	"\vGetWeighted\x12\x17.rng.v1.WeightedRequest\x1a\x18.rng.v1.WeightedResponse\"\x00\x12<\n" +
+	"\aShuffle\x12\x16.rng.v1.ShuffleRequest\x1a\x17.rng.v1.ShuffleResponse\"\x00\x129\n" +
+	"\x06Sample\x12\x15.rng.v1.SampleRequest\x1a\x16.rng.v1.SampleResponse\"\x00\x12E\n" +
+	"\x10StartFairSession\x12\x1a.rng.v1.FairSessionRequest\x1a\x13.rng.v1.FairSession\"\x00\x12K\n" +
+	"\x0eGetFairNumbers\x12\x1a.rng.v1.FairNumbersRequest\x1a\x1b.rng.v1.FairNumbersResponse\"\x00\x12Q\n" +
+	"\x0eRotateFairSeed\x12\x1d.rng.v1.RotateFairSeedRequest\x1a\x1e.rng.v1.RotateFairSeedResponse\"\x00BNZLgithub.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1;rngv1b\x06proto3"
+
+var (
+	file_rng_v1_rng_proto_rawDescOnce sync.Once
+	file_rng_v1_rng_proto_rawDescData []byte
+)
+
+func file_rng_v1_rng_proto_rawDescGZIP() []byte {
+	file_rng_v1_rng_proto_rawDescOnce.Do(func() {
+		file_rng_v1_rng_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rng_v1_rng_proto_rawDesc), len(file_rng_v1_rng_proto_rawDesc)))
+	})
+	return file_rng_v1_rng_proto_rawDescData
+}
+
+var file_rng_v1_rng_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_rng_v1_rng_proto_goTypes = []any{
+	(*RNGRequest)(nil),             // 0: rng.v1.RNGRequest
+	(*RNGResponse)(nil),            // 1: rng.v1.RNGResponse
+	(*StreamNumbersRequest)(nil),   // 2: rng.v1.StreamNumbersRequest
+	(*StreamNumbersResponse)(nil),  // 3: rng.v1.StreamNumbersResponse
+	(*WeightTable)(nil),            // 4: rng.v1.WeightTable
+	(*WeightedRequest)(nil),        // 5: rng.v1.WeightedRequest
+	(*WeightedResponse)(nil),       // 6: rng.v1.WeightedResponse
+	(*ShuffleRequest)(nil),         // 7: rng.v1.ShuffleRequest
+	(*ShuffleResponse)(nil),        // 8: rng.v1.ShuffleResponse
+	(*SampleRequest)(nil),          // 9: rng.v1.SampleRequest
+	(*SampleResponse)(nil),         // 10: rng.v1.SampleResponse
+	(*FairSessionRequest)(nil),     // 11: rng.v1.FairSessionRequest
+	(*FairSession)(nil),            // 12: rng.v1.FairSession
+	(*FairNumbersRequest)(nil),     // 13: rng.v1.FairNumbersRequest
+	(*FairNumbersResponse)(nil),    // 14: rng.v1.FairNumbersResponse
+	(*RotateFairSeedRequest)(nil),  // 15: rng.v1.RotateFairSeedRequest
+	(*RotateFairSeedResponse)(nil), // 16: rng.v1.RotateFairSeedResponse
+}
+var file_rng_v1_rng_proto_depIdxs = []int32{
+	4,  // 0: rng.v1.WeightedRequest.tables:type_name -> rng.v1.WeightTable
+	12, // 1: rng.v1.RotateFairSeedResponse.next:type_name -> rng.v1.FairSession
+	0,  // 2: rng.v1.RNGService.GetNumbers:input_type -> rng.v1.RNGRequest
+	2,  // 3: rng.v1.RNGService.StreamNumbers:input_type -> rng.v1.StreamNumbersRequest
+	5,  // 4: rng.v1.RNGService.GetWeighted:input_type -> rng.v1.WeightedRequest
+	7,  // 5: rng.v1.RNGService.Shuffle:input_type -> rng.v1.ShuffleRequest
+	9,  // 6: rng.v1.RNGService.Sample:input_type -> rng.v1.SampleRequest
+	11, // 7: rng.v1.RNGService.StartFairSession:input_type -> rng.v1.FairSessionRequest
+	13, // 8: rng.v1.RNGService.GetFairNumbers:input_type -> rng.v1.FairNumbersRequest
+	15, // 9: rng.v1.RNGService.RotateFairSeed:input_type -> rng.v1.RotateFairSeedRequest
+	1,  // 10: rng.v1.RNGService.GetNumbers:output_type -> rng.v1.RNGResponse
+	3,  // 11: rng.v1.RNGService.StreamNumbers:output_type -> rng.v1.StreamNumbersResponse
+	6,  // 12: rng.v1.RNGService.GetWeighted:output_type -> rng.v1.WeightedResponse
+	8,  // 13: rng.v1.RNGService.Shuffle:output_type -> rng.v1.ShuffleResponse
+	10, // 14: rng.v1.RNGService.Sample:output_type -> rng.v1.SampleResponse
+	12, // 15: rng.v1.RNGService.StartFairSession:output_type -> rng.v1.FairSession
+	14, // 16: rng.v1.RNGService.GetFairNumbers:output_type -> rng.v1.FairNumbersResponse
+	16, // 17: rng.v1.RNGService.RotateFairSeed:output_type -> rng.v1.RotateFairSeedResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rng_v1_rng_proto_init() }
+func file_rng_v1_rng_proto_init() {
+	if File_rng_v1_rng_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rng_v1_rng_proto_rawDesc), len(file_rng_v1_rng_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rng_v1_rng_proto_goTypes,
+		DependencyIndexes: file_rng_v1_rng_proto_depIdxs,
+		MessageInfos:      file_rng_v1_rng_proto_msgTypes,
+	}.Build()
+	File_rng_v1_rng_proto = out.File
+	file_rng_v1_rng_proto_goTypes = nil
+	file_rng_v1_rng_proto_depIdxs = nil
+}
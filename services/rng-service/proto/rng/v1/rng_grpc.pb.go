@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rng/v1/rng.proto
+
+package rngv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RNGService_GetNumbers_FullMethodName       = "/rng.v1.RNGService/GetNumbers"
+	RNGService_StreamNumbers_FullMethodName    = "/rng.v1.RNGService/StreamNumbers"
+	RNGService_GetWeighted_FullMethodName      = "/rng.v1.RNGService/GetWeighted"
+	RNGService_Shuffle_FullMethodName          = "/rng.v1.RNGService/Shuffle"
+	RNGService_Sample_FullMethodName           = "/rng.v1.RNGService/Sample"
+	RNGService_StartFairSession_FullMethodName = "/rng.v1.RNGService/StartFairSession"
+	RNGService_GetFairNumbers_FullMethodName   = "/rng.v1.RNGService/GetFairNumbers"
+	RNGService_RotateFairSeed_FullMethodName   = "/rng.v1.RNGService/RotateFairSeed"
+)
+
+// RNGServiceClient is the client API for RNGService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RNGServiceClient interface {
+	// One draw per bound (or count draws in [0, 100)), recorded in the audit log.
+	GetNumbers(ctx context.Context, in *RNGRequest, opts ...grpc.CallOption) (*RNGResponse, error)
+	// Bulk draws for simulators and prefetching. The server keeps sending
+	// until the requested frames are delivered or the client cancels; gRPC
+	// flow control pauses it whenever the client stops reading.
+	StreamNumbers(ctx context.Context, in *StreamNumbersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNumbersResponse], error)
+	// Weighted selection: one index per weight table, chosen with
+	// probability weight / sum(weights) using exact integer arithmetic.
+	GetWeighted(ctx context.Context, in *WeightedRequest, opts ...grpc.CallOption) (*WeightedResponse, error)
+	// Card and keno draws: a uniform permutation of count items (Fisher-Yates),
+	// and count unique values from 1..population in draw order.
+	Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffleResponse, error)
+	Sample(ctx context.Context, in *SampleRequest, opts ...grpc.CallOption) (*SampleResponse, error)
+	// Provably fair (commit-reveal) draws. See package fair for the derivation.
+	StartFairSession(ctx context.Context, in *FairSessionRequest, opts ...grpc.CallOption) (*FairSession, error)
+	GetFairNumbers(ctx context.Context, in *FairNumbersRequest, opts ...grpc.CallOption) (*FairNumbersResponse, error)
+	RotateFairSeed(ctx context.Context, in *RotateFairSeedRequest, opts ...grpc.CallOption) (*RotateFairSeedResponse, error)
+}
+
+type rNGServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRNGServiceClient(cc grpc.ClientConnInterface) RNGServiceClient {
+	return &rNGServiceClient{cc}
+}
+
+func (c *rNGServiceClient) GetNumbers(ctx context.Context, in *RNGRequest, opts ...grpc.CallOption) (*RNGResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RNGResponse)
+	err := c.cc.Invoke(ctx, RNGService_GetNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) StreamNumbers(ctx context.Context, in *StreamNumbersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNumbersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RNGService_ServiceDesc.Streams[0], RNGService_StreamNumbers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamNumbersRequest, StreamNumbersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RNGService_StreamNumbersClient = grpc.ServerStreamingClient[StreamNumbersResponse]
+
+func (c *rNGServiceClient) GetWeighted(ctx context.Context, in *WeightedRequest, opts ...grpc.CallOption) (*WeightedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WeightedResponse)
+	err := c.cc.Invoke(ctx, RNGService_GetWeighted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShuffleResponse)
+	err := c.cc.Invoke(ctx, RNGService_Shuffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) Sample(ctx context.Context, in *SampleRequest, opts ...grpc.CallOption) (*SampleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SampleResponse)
+	err := c.cc.Invoke(ctx, RNGService_Sample_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) StartFairSession(ctx context.Context, in *FairSessionRequest, opts ...grpc.CallOption) (*FairSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FairSession)
+	err := c.cc.Invoke(ctx, RNGService_StartFairSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) GetFairNumbers(ctx context.Context, in *FairNumbersRequest, opts ...grpc.CallOption) (*FairNumbersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FairNumbersResponse)
+	err := c.cc.Invoke(ctx, RNGService_GetFairNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNGServiceClient) RotateFairSeed(ctx context.Context, in *RotateFairSeedRequest, opts ...grpc.CallOption) (*RotateFairSeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateFairSeedResponse)
+	err := c.cc.Invoke(ctx, RNGService_RotateFairSeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RNGServiceServer is the server API for RNGService service.
+// All implementations must embed UnimplementedRNGServiceServer
+// for forward compatibility.
+type RNGServiceServer interface {
+	// One draw per bound (or count draws in [0, 100)), recorded in the audit log.
+	GetNumbers(context.Context, *RNGRequest) (*RNGResponse, error)
+	// Bulk draws for simulators and prefetching. The server keeps sending
+	// until the requested frames are delivered or the client cancels; gRPC
+	// flow control pauses it whenever the client stops reading.
+	StreamNumbers(*StreamNumbersRequest, grpc.ServerStreamingServer[StreamNumbersResponse]) error
+	// Weighted selection: one index per weight table, chosen with
+	// probability weight / sum(weights) using exact integer arithmetic.
+	GetWeighted(context.Context, *WeightedRequest) (*WeightedResponse, error)
+	// Card and keno draws: a uniform permutation of count items (Fisher-Yates),
+	// and count unique values from 1..population in draw order.
+	Shuffle(context.Context, *ShuffleRequest) (*ShuffleResponse, error)
+	Sample(context.Context, *SampleRequest) (*SampleResponse, error)
+	// Provably fair (commit-reveal) draws. See package fair for the derivation.
+	StartFairSession(context.Context, *FairSessionRequest) (*FairSession, error)
+	GetFairNumbers(context.Context, *FairNumbersRequest) (*FairNumbersResponse, error)
+	RotateFairSeed(context.Context, *RotateFairSeedRequest) (*RotateFairSeedResponse, error)
+	mustEmbedUnimplementedRNGServiceServer()
+}
+
+// UnimplementedRNGServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRNGServiceServer struct{}
+
+func (UnimplementedRNGServiceServer) GetNumbers(context.Context, *RNGRequest) (*RNGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumbers not implemented")
+}
+func (UnimplementedRNGServiceServer) StreamNumbers(*StreamNumbersRequest, grpc.ServerStreamingServer[StreamNumbersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNumbers not implemented")
+}
+func (UnimplementedRNGServiceServer) GetWeighted(context.Context, *WeightedRequest) (*WeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeighted not implemented")
+}
+func (UnimplementedRNGServiceServer) Shuffle(context.Context, *ShuffleRequest) (*ShuffleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shuffle not implemented")
+}
+func (UnimplementedRNGServiceServer) Sample(context.Context, *SampleRequest) (*SampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sample not implemented")
+}
+func (UnimplementedRNGServiceServer) StartFairSession(context.Context, *FairSessionRequest) (*FairSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFairSession not implemented")
+}
+func (UnimplementedRNGServiceServer) GetFairNumbers(context.Context, *FairNumbersRequest) (*FairNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFairNumbers not implemented")
+}
+func (UnimplementedRNGServiceServer) RotateFairSeed(context.Context, *RotateFairSeedRequest) (*RotateFairSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFairSeed not implemented")
+}
+func (UnimplementedRNGServiceServer) mustEmbedUnimplementedRNGServiceServer() {}
+func (UnimplementedRNGServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeRNGServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RNGServiceServer will
+// result in compilation errors.
+type UnsafeRNGServiceServer interface {
+	mustEmbedUnimplementedRNGServiceServer()
+}
+
+func RegisterRNGServiceServer(s grpc.ServiceRegistrar, srv RNGServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRNGServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RNGService_ServiceDesc, srv)
+}
+
+func _RNGService_GetNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RNGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).GetNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_GetNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).GetNumbers(ctx, req.(*RNGRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_StreamNumbers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNumbersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RNGServiceServer).StreamNumbers(m, &grpc.GenericServerStream[StreamNumbersRequest, StreamNumbersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RNGService_StreamNumbersServer = grpc.ServerStreamingServer[StreamNumbersResponse]
+
+func _RNGService_GetWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeightedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).GetWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_GetWeighted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).GetWeighted(ctx, req.(*WeightedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_Shuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).Shuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_Shuffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).Shuffle(ctx, req.(*ShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_Sample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).Sample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_Sample_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).Sample(ctx, req.(*SampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_StartFairSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FairSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).StartFairSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_StartFairSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).StartFairSession(ctx, req.(*FairSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_GetFairNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FairNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).GetFairNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_GetFairNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).GetFairNumbers(ctx, req.(*FairNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNGService_RotateFairSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateFairSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNGServiceServer).RotateFairSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RNGService_RotateFairSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNGServiceServer).RotateFairSeed(ctx, req.(*RotateFairSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RNGService_ServiceDesc is the grpc.ServiceDesc for RNGService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RNGService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rng.v1.RNGService",
+	HandlerType: (*RNGServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNumbers",
+			Handler:    _RNGService_GetNumbers_Handler,
+		},
+		{
+			MethodName: "GetWeighted",
+			Handler:    _RNGService_GetWeighted_Handler,
+		},
+		{
+			MethodName: "Shuffle",
+			Handler:    _RNGService_Shuffle_Handler,
+		},
+		{
+			MethodName: "Sample",
+			Handler:    _RNGService_Sample_Handler,
+		},
+		{
+			MethodName: "StartFairSession",
+			Handler:    _RNGService_StartFairSession_Handler,
+		},
+		{
+			MethodName: "GetFairNumbers",
+			Handler:    _RNGService_GetFairNumbers_Handler,
+		},
+		{
+			MethodName: "RotateFairSeed",
+			Handler:    _RNGService_RotateFairSeed_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNumbers",
+			Handler:       _RNGService_StreamNumbers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rng/v1/rng.proto",
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// maxShuffleDraws caps the items in a shuffle and the values in a sample.
+const maxShuffleDraws = 1 << 16
+
+// Shuffle returns a uniformly random permutation of 0..count-1 using
+// Fisher-Yates: for i from count-1 down to 1, swap item i with a uniform
+// j in [0, i].
+func (s *rngServer) Shuffle(ctx context.Context, req *pb.ShuffleRequest) (*pb.ShuffleResponse, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	n := int(req.GetCount())
+	if n <= 0 || n > maxShuffleDraws {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be in 1..%d", maxShuffleDraws)
+	}
+
+	bounds := make([]int64, 0, n-1)
+	for i := n - 1; i >= 1; i-- {
+		bounds = append(bounds, int64(i+1))
+	}
+
+	st, err := s.streams.get(req.GetStream())
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	draws, auditID, err := st.drawFreshLocked(bounds)
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	perm := make([]int64, n)
+	for i := range perm {
+		perm[i] = int64(i)
+	}
+	for k, i := 0, n-1; i >= 1; k, i = k+1, i-1 {
+		j := draws[k]
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+
+	meta := drawMeta{requestID: req.GetRequestId(), caller: req.GetCaller(), roundID: req.GetRoundId()}
+	if err := s.record(st, audit.Record{Kind: "shuffle", Bounds: bounds, Outputs: perm}, meta, auditID); err != nil {
+		return nil, err
+	}
+	return &pb.ShuffleResponse{Permutation: perm, AuditId: auditID}, nil
+}
+
+// Sample draws count unique values from 1..population, in draw order, by a
+// partial Fisher-Yates shuffle over a sparse map so population can be large.
+func (s *rngServer) Sample(ctx context.Context, req *pb.SampleRequest) (*pb.SampleResponse, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	population := req.GetPopulation()
+	k := int64(req.GetCount())
+	if population <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "population must be positive")
+	}
+	if k <= 0 || k > population || k > maxShuffleDraws {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be in 1..min(population, %d)", maxShuffleDraws)
+	}
+
+	bounds := make([]int64, k)
+	for i := range bounds {
+		bounds[i] = population - int64(i)
+	}
+
+	st, err := s.streams.get(req.GetStream())
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	draws, auditID, err := st.drawFreshLocked(bounds)
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	// swapped[p] is the value now at position p, for positions that have
+	// been swapped; every other position p still holds p.
+	swapped := make(map[int64]int64, 2*k)
+	at := func(p int64) int64 {
+		if v, ok := swapped[p]; ok {
+			return v
+		}
+		return p
+	}
+	values := make([]int64, k)
+	for i := int64(0); i < k; i++ {
+		j := i + draws[i]
+		vi, vj := at(i), at(j)
+		swapped[i], swapped[j] = vj, vi
+		values[i] = vj + 1
+	}
+
+	meta := drawMeta{requestID: req.GetRequestId(), caller: req.GetCaller(), roundID: req.GetRoundId()}
+	if err := s.record(st, audit.Record{Kind: "sample", Bounds: bounds, Outputs: values}, meta, auditID); err != nil {
+		return nil, err
+	}
+	return &pb.SampleResponse{Values: values, AuditId: auditID}, nil
+}
+
+// drawFreshLocked is drawLocked for long dependent sequences such as shoe
+// shuffles. A single DRBG seed holds 256 bits of security strength, fewer
+// than log2(N!) for a multi-deck shoe, so the sequence is split into chunks
+// of at most that many bits with a reseed from fresh entropy between them.
+// Every outcome of the sequence is then reachable. Callers must hold st.mu.
+func (st *rngStream) drawFreshLocked(bounds []int64) ([]int64, string, error) {
+	const budget = drbg.SecurityStrength * 8
+
+	out := make([]int64, 0, len(bounds))
+	var auditID string
+	flush := func(chunk []int64) error {
+		draws, id, err := st.drawLocked(chunk)
+		if err != nil {
+			return err
+		}
+		out = append(out, draws...)
+		auditID = id
+		return nil
+	}
+
+	start, bits := 0, 0.0
+	for i, b := range bounds {
+		need := math.Log2(float64(b))
+		if i > start && bits+need > budget {
+			if err := flush(bounds[start:i]); err != nil {
+				return nil, "", err
+			}
+			if err := st.gen.Reseed(nil); err != nil {
+				return nil, "", err
+			}
+			start, bits = i, 0
+		}
+		bits += need
+	}
+	if err := flush(bounds[start:]); err != nil {
+		return nil, "", err
+	}
+	return out, auditID, nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+func TestShuffle(t *testing.T) {
+	s, path := newTestServer(t)
+	for _, n := range []int32{1, 2, 52, 416} {
+		resp, err := s.Shuffle(context.Background(), &pb.ShuffleRequest{Count: n})
+		if err != nil {
+			t.Fatalf("shuffle of %d: %v", n, err)
+		}
+		perm := resp.GetPermutation()
+		seen := make([]bool, n)
+		for _, v := range perm {
+			if v < 0 || v >= int64(n) || seen[v] {
+				t.Fatalf("shuffle of %d: %v is not a permutation of 0..%d", n, perm, n-1)
+			}
+			seen[v] = true
+		}
+		if len(perm) != int(n) {
+			t.Errorf("shuffle of %d returned %d items", n, len(perm))
+		}
+	}
+
+	recs := auditRecords(t, path)
+	if len(recs) != 4 {
+		t.Fatalf("%d audit records, want 4", len(recs))
+	}
+	// Item i is swapped with one of the first i+1, for i from 51 down to 1.
+	rec := recs[2]
+	if rec.Kind != "shuffle" || len(rec.Bounds) != 51 || rec.Bounds[0] != 52 || rec.Bounds[50] != 2 || len(rec.Outputs) != 52 {
+		t.Errorf("audit record kind %s, bounds %v, %d outputs", rec.Kind, rec.Bounds, len(rec.Outputs))
+	}
+}
+
+func TestSample(t *testing.T) {
+	tests := []struct {
+		population int64
+		count      int32
+	}{
+		{80, 20},
+		{80, 79},
+		{80, 80},
+		{1, 1},
+		{1 << 40, 100},
+	}
+	s, _ := newTestServer(t)
+	for _, tt := range tests {
+		resp, err := s.Sample(context.Background(), &pb.SampleRequest{Population: tt.population, Count: tt.count})
+		if err != nil {
+			t.Fatalf("sample %d of %d: %v", tt.count, tt.population, err)
+		}
+		values := resp.GetValues()
+		seen := make(map[int64]bool, len(values))
+		for _, v := range values {
+			if v < 1 || v > tt.population || seen[v] {
+				t.Fatalf("sample %d of %d: %v has a value out of range or repeated", tt.count, tt.population, values)
+			}
+			seen[v] = true
+		}
+		if len(values) != int(tt.count) {
+			t.Errorf("sample %d of %d returned %d values", tt.count, tt.population, len(values))
+		}
+	}
+}
+
+func TestShuffleInvalid(t *testing.T) {
+	s, path := newTestServer(t)
+	ctx := context.Background()
+	for _, n := range []int32{0, -1, maxShuffleDraws + 1} {
+		if _, err := s.Shuffle(ctx, &pb.ShuffleRequest{Count: n}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("shuffle of %d: %v, want InvalidArgument", n, err)
+		}
+	}
+	samples := []*pb.SampleRequest{
+		{Population: 0, Count: 1},
+		{Population: -5, Count: 1},
+		{Population: 80, Count: 0},
+		{Population: 80, Count: 81},
+		{Population: 1 << 40, Count: maxShuffleDraws + 1},
+	}
+	for _, req := range samples {
+		if _, err := s.Sample(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("sample %d of %d: %v, want InvalidArgument", req.GetCount(), req.GetPopulation(), err)
+		}
+	}
+	if n := len(auditRecords(t, path)); n != 0 {
+		t.Errorf("%d audit records for rejected requests, want 0", n)
+	}
+}
+
+func TestDrawFreshReseeds(t *testing.T) {
+	s, path := newTestServer(t)
+	st, err := s.streams.get("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Eight 32-bit draws fill the 256-bit budget of one seed, so 100 draws
+	// take 13 chunks with a reseed between each.
+	bounds := make([]int64, 100)
+	for i := range bounds {
+		bounds[i] = 1 << 32
+	}
+	st.mu.Lock()
+	before := st.gen.Info()
+	draws, auditID, err := st.drawFreshLocked(bounds)
+	after := st.gen.Info()
+	st.mu.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := after.Reseeds - before.Reseeds; got != 12 {
+		t.Errorf("%d reseeds, want 12", got)
+	}
+	if len(draws) != len(bounds) {
+		t.Fatalf("%d draws for %d bounds", len(draws), len(bounds))
+	}
+	for i, d := range draws {
+		if d < 0 || d >= bounds[i] {
+			t.Fatalf("draw %d = %d out of range", i, d)
+		}
+	}
+	if auditID == "" {
+		t.Error("no audit id")
+	}
+
+	// A chunked shoe shuffle is one record that still chains, and its DRBG
+	// counter covers every chunk.
+	resp, err := s.Shuffle(context.Background(), &pb.ShuffleRequest{Count: 416})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs := auditRecords(t, path)
+	if len(recs) != 1 {
+		t.Fatalf("%d audit records, want 1", len(recs))
+	}
+	rec := recs[0]
+	if rec.AuditID != resp.GetAuditId() || !reflect.DeepEqual(rec.Outputs, resp.GetPermutation()) {
+		t.Errorf("audit record %s does not match the shuffle %s", rec.AuditID, resp.GetAuditId())
+	}
+	if rec.DRBGCounter != st.gen.Info().Generated {
+		t.Errorf("audit record DRBG counter %d, want %d", rec.DRBGCounter, st.gen.Info().Generated)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := audit.Verify(f); err != nil {
+		t.Errorf("audit log: %v", err)
+	}
+}
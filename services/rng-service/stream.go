@@ -0,0 +1,67 @@
+package main
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// maxStreamMessageDraws caps the values packed into one stream message.
+const maxStreamMessageDraws = 65536
+
+// StreamNumbers sends frames of bounded draws until req.Frames have been
+// delivered or the client goes away. Every message is drawn and audited like
+// a GetNumbers call. Send blocks while the client's flow-control window is
+// full, so a slow reader throttles generation instead of buffering it.
+func (s *rngServer) StreamNumbers(req *pb.StreamNumbersRequest, stream pb.RNGService_StreamNumbersServer) error {
+	if len(req.GetBounds()) == 0 {
+		return status.Error(codes.InvalidArgument, "bounds is required")
+	}
+	frame, err := drawBounds(&pb.RNGRequest{Bounds: req.GetBounds()})
+	if err != nil {
+		return err
+	}
+	perMessage := uint64(req.GetFramesPerMessage())
+	if perMessage == 0 {
+		perMessage = 1
+	}
+	if perMessage*uint64(len(frame)) > maxStreamMessageDraws {
+		return status.Errorf(codes.InvalidArgument, "frames_per_message * len(bounds) exceeds %d", maxStreamMessageDraws)
+	}
+
+	st, err := s.streams.get(req.GetStream())
+	if err != nil {
+		return err
+	}
+
+	meta := drawMeta{requestID: req.GetRequestId(), caller: req.GetCaller(), roundID: req.GetRoundId()}
+	ctx := stream.Context()
+	for sent := uint64(0); req.GetFrames() == 0 || sent < req.GetFrames(); {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if err := s.checkHealth(); err != nil {
+			return err
+		}
+
+		n := perMessage
+		if req.GetFrames() != 0 && req.GetFrames()-sent < n {
+			n = req.GetFrames() - sent
+		}
+		bounds := make([]int64, 0, n*uint64(len(frame)))
+		for i := uint64(0); i < n; i++ {
+			bounds = append(bounds, frame...)
+		}
+
+		numbers, auditID, err := s.drawNumbers(st, "stream", meta, bounds)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&pb.StreamNumbersResponse{Numbers: numbers, FirstFrame: sent, AuditId: auditID}); err != nil {
+			return err
+		}
+		sent += n
+	}
+	return nil
+}
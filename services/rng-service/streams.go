@@ -0,0 +1,142 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/draw"
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+)
+
+const (
+	// defaultStream serves requests that do not name a stream.
+	defaultStream = "default"
+
+	// maxStreams caps the number of streams a client can cause to exist.
+	maxStreams = 1024
+
+	// maxStreamName caps the length of a stream name.
+	maxStreamName = 64
+)
+
+// rngStream is one isolated DRBG instance, normally per game_code or per
+// operator. Streams share only the health-tested entropy source: each has its
+// own DRBG state, reseed schedule and counters, so a fault or state exposure
+// in one cannot influence another.
+type rngStream struct {
+	name string
+	gen  *drbg.DRBG
+	src  *draw.Source
+
+	// mu serialises audited draws and background cycling on this stream so
+	// each record's DRBG counter reflects exactly that draw.
+	mu sync.Mutex
+	// cycles counts background cycles of this stream. Guarded by mu.
+	cycles uint64
+}
+
+// streamSet creates streams on first use and holds them by name.
+type streamSet struct {
+	// base is the DRBG configuration shared by all streams.
+	base drbg.Config
+	// intervals overrides the reseed interval of named streams.
+	intervals map[string]uint64
+
+	mu      sync.Mutex
+	streams map[string]*rngStream
+}
+
+// get returns the named stream, instantiating it if needed. An empty name
+// selects defaultStream.
+func (ss *streamSet) get(name string) (*rngStream, error) {
+	if name == "" {
+		name = defaultStream
+	}
+	if len(name) > maxStreamName {
+		return nil, status.Errorf(codes.InvalidArgument, "stream name longer than %d bytes", maxStreamName)
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if st, ok := ss.streams[name]; ok {
+		return st, nil
+	}
+	if len(ss.streams) >= maxStreams {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many RNG streams (max %d)", maxStreams)
+	}
+
+	cfg := ss.base
+	if n, ok := ss.intervals[name]; ok {
+		cfg.ReseedInterval = n
+	}
+	cfg.Personalization = streamPersonalization(name)
+	gen, err := drbg.New(cfg)
+	if err != nil {
+		log.Printf("failed to instantiate DRBG for stream %q: %v", name, err)
+		return nil, status.Error(codes.Internal, "rng stream unavailable")
+	}
+
+	st := &rngStream{name: name, gen: gen, src: draw.NewSource(gen)}
+	if ss.streams == nil {
+		ss.streams = make(map[string]*rngStream)
+	}
+	ss.streams[name] = st
+
+	info := gen.Info()
+	log.Printf("RNG stream %q: DRBG %s instantiated (reseed interval %d, prediction resistance %t)",
+		name, info.Algorithm, info.ReseedInterval, info.PredictionResistance)
+	return st, nil
+}
+
+// streamPersonalization returns the personalization string for a stream,
+// which separates the streams' states even if two were seeded identically.
+// The name is hashed so the string fits every mechanism: CTR_DRBG without a
+// derivation function takes at most seedlen (48) bytes.
+func streamPersonalization(name string) []byte {
+	sum := sha256.Sum256([]byte(name))
+	return append([]byte("echobetz-rng/"), sum[:]...)
+}
+
+// all returns a snapshot of the instantiated streams.
+func (ss *streamSet) all() []*rngStream {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	out := make([]*rngStream, 0, len(ss.streams))
+	for _, st := range ss.streams {
+		out = append(out, st)
+	}
+	return out
+}
+
+// streamIntervalsFromEnv parses per-stream reseed intervals:
+//
+//	RNG_STREAM_RESEED_INTERVALS  e.g. "aurora_star=100000,keno=5000"
+//
+// Streams not listed use RNG_RESEED_INTERVAL.
+func streamIntervalsFromEnv() (map[string]uint64, error) {
+	intervals := make(map[string]uint64)
+	v := os.Getenv("RNG_STREAM_RESEED_INTERVALS")
+	if v == "" {
+		return intervals, nil
+	}
+	for _, entry := range strings.Split(v, ",") {
+		name, interval, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid stream interval %q, want name=interval", entry)
+		}
+		n, err := strconv.ParseUint(interval, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("stream %q: %v", name, err)
+		}
+		intervals[name] = n
+	}
+	return intervals, nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/drbg"
+)
+
+func TestStreamMaxLengthName(t *testing.T) {
+	name := strings.Repeat("s", maxStreamName)
+	for _, alg := range []string{"hmac-sha256", "ctr-aes256"} {
+		t.Run(alg, func(t *testing.T) {
+			ss := &streamSet{base: drbg.Config{Algorithm: alg, Entropy: rand.Reader}}
+			st, err := ss.get(name)
+			if err != nil {
+				t.Fatalf("stream %d bytes long: %v", len(name), err)
+			}
+			if _, _, err := st.drawLocked([]int64{10}); err != nil {
+				t.Errorf("draw: %v", err)
+			}
+			if _, err := ss.get(name + "s"); err == nil {
+				t.Errorf("stream longer than %d bytes accepted", maxStreamName)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/audit"
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+// maxWeights caps the weights in one GetWeighted table.
+const maxWeights = 1 << 16
+
+// GetWeighted picks one index from each weight table. Each pick is a single
+// unbiased draw r in [0, total) followed by a cumulative scan, so index i is
+// chosen with probability exactly weights[i] / total.
+func (s *rngServer) GetWeighted(ctx context.Context, req *pb.WeightedRequest) (*pb.WeightedResponse, error) {
+	if err := s.checkHealth(); err != nil {
+		return nil, err
+	}
+	if len(req.GetTables()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one weight table is required")
+	}
+	if len(req.GetTables()) > maxDraws {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d weight tables per request", maxDraws)
+	}
+
+	weights := make([][]int64, len(req.GetTables()))
+	totals := make([]int64, len(req.GetTables()))
+	for t, table := range req.GetTables() {
+		if len(table.GetWeights()) > maxWeights {
+			return nil, status.Errorf(codes.InvalidArgument, "tables[%d]: at most %d weights per table", t, maxWeights)
+		}
+		total, err := weightTotal(table.GetWeights())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tables[%d]: %v", t, err)
+		}
+		weights[t] = table.GetWeights()
+		totals[t] = total
+	}
+
+	st, err := s.streams.get(req.GetStream())
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	draws, auditID, err := st.drawLocked(totals)
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	indices := make([]int64, len(draws))
+	for t, r := range draws {
+		indices[t] = int64(pickWeighted(weights[t], r))
+	}
+
+	meta := drawMeta{requestID: req.GetRequestId(), caller: req.GetCaller(), roundID: req.GetRoundId()}
+	rec := audit.Record{Kind: "weighted", Bounds: totals, Outputs: indices, Weights: weights}
+	if err := s.record(st, rec, meta, auditID); err != nil {
+		return nil, err
+	}
+	return &pb.WeightedResponse{Indices: indices, AuditId: auditID}, nil
+}
+
+// weightTotal validates a weight table and returns the sum of its weights.
+func weightTotal(weights []int64) (int64, error) {
+	var total int64
+	for i, w := range weights {
+		if w < 0 {
+			return 0, fmt.Errorf("weights[%d] is negative", i)
+		}
+		if w > math.MaxInt64-total {
+			return 0, errors.New("weights overflow int64")
+		}
+		total += w
+	}
+	if total == 0 {
+		return 0, errors.New("weights must not all be zero")
+	}
+	return total, nil
+}
+
+// pickWeighted returns the index whose cumulative weight range contains r,
+// for r in [0, sum(weights)).
+func pickWeighted(weights []int64, r int64) int {
+	for i, w := range weights {
+		if r < w {
+			return i
+		}
+		r -= w
+	}
+	panic("pickWeighted: r out of range")
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ShadyDevelopment/ECHOBETZ/services/rng-service/proto/rng/v1"
+)
+
+func TestPickWeighted(t *testing.T) {
+	// Every r in [0, 5) maps to the index whose range holds it; the zero
+	// weights own no range.
+	weights := []int64{0, 3, 0, 2, 0}
+	want := []int{1, 1, 1, 3, 3}
+	for r, i := range want {
+		if got := pickWeighted(weights, int64(r)); got != i {
+			t.Errorf("pickWeighted(%d) = %d, want %d", r, got, i)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("r equal to the total did not panic")
+		}
+	}()
+	pickWeighted(weights, 5)
+}
+
+func TestWeightTotal(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int64
+		total   int64
+		ok      bool
+	}{
+		{"sum", []int64{0, 3, 0, 2}, 5, true},
+		{"max total", []int64{math.MaxInt64 - 1, 1}, math.MaxInt64, true},
+		{"overflow", []int64{math.MaxInt64, 1}, 0, false},
+		{"overflow late", []int64{1, math.MaxInt64 - 1, 1}, 0, false},
+		{"negative", []int64{3, -1}, 0, false},
+		{"all zero", []int64{0, 0}, 0, false},
+		{"empty", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := weightTotal(tt.weights)
+			if (err == nil) != tt.ok || total != tt.total {
+				t.Errorf("got %d, %v; want %d, ok %v", total, err, tt.total, tt.ok)
+			}
+		})
+	}
+}
+
+func TestGetWeighted(t *testing.T) {
+	s, path := newTestServer(t)
+	req := &pb.WeightedRequest{
+		Tables:  []*pb.WeightTable{{Weights: []int64{0, 7, 0}}, {Weights: []int64{5}}},
+		Caller:  "test",
+		RoundId: "round-1",
+	}
+	resp, err := s.GetWeighted(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1, 0}; !reflect.DeepEqual(resp.GetIndices(), want) {
+		t.Errorf("indices %v, want %v", resp.GetIndices(), want)
+	}
+
+	recs := auditRecords(t, path)
+	if len(recs) != 1 {
+		t.Fatalf("%d audit records, want 1", len(recs))
+	}
+	rec := recs[0]
+	if rec.Kind != "weighted" || rec.AuditID != resp.GetAuditId() || rec.Caller != "test" || rec.RoundID != "round-1" {
+		t.Errorf("audit record %+v does not match the request", rec)
+	}
+	if !reflect.DeepEqual(rec.Bounds, []int64{7, 5}) || !reflect.DeepEqual(rec.Outputs, resp.GetIndices()) ||
+		!reflect.DeepEqual(rec.Weights, [][]int64{{0, 7, 0}, {5}}) {
+		t.Errorf("audit record bounds %v, outputs %v, weights %v", rec.Bounds, rec.Outputs, rec.Weights)
+	}
+}
+
+func TestGetWeightedInvalid(t *testing.T) {
+	// table returns a valid table of n weights.
+	table := func(n int) *pb.WeightTable {
+		w := make([]int64, n)
+		for i := range w {
+			w[i] = 1
+		}
+		return &pb.WeightTable{Weights: w}
+	}
+	zero := &pb.WeightTable{Weights: []int64{0, 0, 0}}
+	tests := []struct {
+		name   string
+		tables []*pb.WeightTable
+	}{
+		{"no tables", nil},
+		{"all zero", []*pb.WeightTable{table(1), zero}},
+		{"too many tables", make([]*pb.WeightTable, maxDraws+1)},
+		{"table too long", []*pb.WeightTable{table(maxWeights + 1)}},
+	}
+	s, path := newTestServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.GetWeighted(context.Background(), &pb.WeightedRequest{Tables: tt.tables})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("got %v, want InvalidArgument", err)
+			}
+		})
+	}
+	if n := len(auditRecords(t, path)); n != 0 {
+		t.Errorf("%d audit records for rejected requests, want 0", n)
+	}
+}